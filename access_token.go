@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"time"
 )
 
 // AccessTokensService service
@@ -18,6 +19,35 @@ type AccessToken struct {
 	Name      string   `json:"name,omitempty"`
 	RevokedAt string   `json:"revokedAt,omitempty"`
 	Scopes    []string `json:"scopes,omitempty"`
+
+	// Token is the secret value, only returned when the token is created
+	Token string `json:"token,omitempty"`
+
+	// ExpiresIn sets the lifetime in seconds when creating a token
+	ExpiresIn int `json:"expiresIn,omitempty"`
+}
+
+// ExpiresAt returns the expiry of the token, false if it does not expire
+func (accessToken *AccessToken) ExpiresAt() (time.Time, bool) {
+	return accessToken.sysTime(func(sys *Sys) string { return sys.ExpiresAt })
+}
+
+// LastUsedAt returns when the token was last used, false if it was never used
+func (accessToken *AccessToken) LastUsedAt() (time.Time, bool) {
+	return accessToken.sysTime(func(sys *Sys) string { return sys.LastUsedAt })
+}
+
+func (accessToken *AccessToken) sysTime(field func(sys *Sys) string) (time.Time, bool) {
+	if accessToken.Sys == nil || field(accessToken.Sys) == "" {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339, field(accessToken.Sys))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
 }
 
 // GetVersion returns entity version
@@ -61,7 +91,7 @@ func (service *AccessTokensService) Get(ctx context.Context, accessTokenID strin
 
 	var accessToken AccessToken
 	req.Header.Set("X-Contentful-Version", strconv.Itoa(accessToken.GetVersion()))
-	if ok := service.c.do(req, &accessToken); ok != nil {
+	if err := service.c.do(req, &accessToken); err != nil {
 		return nil, err
 	}
 
@@ -70,7 +100,16 @@ func (service *AccessTokensService) Get(ctx context.Context, accessTokenID strin
 
 // Create creates a new access token
 func (service *AccessTokensService) Create(ctx context.Context, accessToken *AccessToken) error {
-	bytesArray, err := json.Marshal(accessToken)
+	// the token secret of a rotated token is not sent back
+	bytesArray, err := json.Marshal(struct {
+		Name      string   `json:"name,omitempty"`
+		Scopes    []string `json:"scopes,omitempty"`
+		ExpiresIn int      `json:"expiresIn,omitempty"`
+	}{
+		Name:      accessToken.Name,
+		Scopes:    accessToken.Scopes,
+		ExpiresIn: accessToken.ExpiresIn,
+	})
 	if err != nil {
 		return err
 	}
@@ -88,11 +127,6 @@ func (service *AccessTokensService) Create(ctx context.Context, accessToken *Acc
 
 // Revoke revokes a personal access token
 func (service *AccessTokensService) Revoke(ctx context.Context, accessToken *AccessToken) error {
-	bytesArray, err := json.Marshal(accessToken)
-	if err != nil {
-		return err
-	}
-
 	var path string
 	var method string
 
@@ -101,7 +135,7 @@ func (service *AccessTokensService) Revoke(ctx context.Context, accessToken *Acc
 		method = "PUT"
 	}
 
-	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,126 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SecretSink stores the secret of a rotated access token, e.g. in a vault
+type SecretSink interface {
+	Store(ctx context.Context, accessToken *AccessToken) error
+}
+
+// SecretSinkFunc adapts a function to a SecretSink
+type SecretSinkFunc func(ctx context.Context, accessToken *AccessToken) error
+
+// Store calls f
+func (f SecretSinkFunc) Store(ctx context.Context, accessToken *AccessToken) error {
+	return f(ctx, accessToken)
+}
+
+// AccessTokenAudit is the state of an access token reported by AccessTokensService.Audit
+type AccessTokenAudit struct {
+	AccessToken *AccessToken
+
+	// Expired tokens can not be used anymore
+	Expired bool
+
+	// ExpiresSoon tokens expire within the audited duration
+	ExpiresSoon bool
+
+	// NeverUsed tokens have not been used since they were created
+	NeverUsed bool
+}
+
+// NeedsRotation reports whether the token expired or expires soon
+func (audit AccessTokenAudit) NeedsRotation() bool {
+	return audit.Expired || audit.ExpiresSoon
+}
+
+// Audit lists the active access tokens of the current user and flags the
+// ones expiring within the given duration and the ones never used
+func (service *AccessTokensService) Audit(ctx context.Context, expiresWithin time.Duration) ([]AccessTokenAudit, error) {
+	col, err := service.List(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	accessTokens, err := col.collectAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	audits := make([]AccessTokenAudit, 0, len(accessTokens))
+	for i := range accessTokens {
+		accessToken := &accessTokens[i]
+		if accessToken.RevokedAt != "" {
+			continue
+		}
+
+		audit := AccessTokenAudit{AccessToken: accessToken}
+		if expiresAt, ok := accessToken.ExpiresAt(); ok {
+			audit.Expired = !expiresAt.After(now)
+			audit.ExpiresSoon = !audit.Expired && expiresAt.Before(now.Add(expiresWithin))
+		}
+		_, used := accessToken.LastUsedAt()
+		audit.NeverUsed = !used
+
+		audits = append(audits, audit)
+	}
+
+	return audits, nil
+}
+
+// Rotate creates a replacement token with the same name and scopes, stores it
+// in the sink and revokes the old token. A zero expiresIn creates a token that
+// does not expire. If the sink fails, the replacement is revoked again and the
+// old token is kept.
+func (service *AccessTokensService) Rotate(ctx context.Context, accessToken *AccessToken, expiresIn time.Duration, sink SecretSink) (*AccessToken, error) {
+	replacement := &AccessToken{
+		Name:      accessToken.Name,
+		Scopes:    accessToken.Scopes,
+		ExpiresIn: int(expiresIn.Seconds()),
+	}
+	if err := service.Create(ctx, replacement); err != nil {
+		return nil, err
+	}
+
+	if sink != nil {
+		if err := sink.Store(ctx, replacement); err != nil {
+			_ = service.Revoke(ctx, replacement)
+			return nil, fmt.Errorf("storing access token %s failed, keeping the old token: %w", accessToken.Name, err)
+		}
+	}
+
+	if err := service.Revoke(ctx, accessToken); err != nil {
+		return replacement, err
+	}
+
+	return replacement, nil
+}
+
+// RotateExpiring rotates the tokens that expired or expire within the given duration
+func (service *AccessTokensService) RotateExpiring(ctx context.Context, expiresWithin, expiresIn time.Duration, sink SecretSink) ([]*AccessToken, error) {
+	audits, err := service.Audit(ctx, expiresWithin)
+	if err != nil {
+		return nil, err
+	}
+
+	var rotated []*AccessToken
+	for _, audit := range audits {
+		if !audit.NeedsRotation() {
+			continue
+		}
+
+		replacement, err := service.Rotate(ctx, audit.AccessToken, expiresIn, sink)
+		if err != nil {
+			return rotated, err
+		}
+
+		rotated = append(rotated, replacement)
+	}
+
+	return rotated, nil
+}
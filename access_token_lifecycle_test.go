@@ -0,0 +1,155 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type accessTokenServer struct {
+	mu      sync.Mutex
+	tokens  []*AccessToken
+	created []*AccessToken
+	revoked []string
+}
+
+func newAccessTokenServer() *accessTokenServer {
+	now := time.Now().UTC()
+	token := func(id, expiresAt, lastUsedAt, revokedAt string) *AccessToken {
+		return &AccessToken{
+			Name:      "token " + id,
+			RevokedAt: revokedAt,
+			Scopes:    []string{"content_management_manage"},
+			Sys: &Sys{
+				ID:         id,
+				Type:       "PersonalAccessToken",
+				CreatedAt:  "2020-03-11T14:43:32Z",
+				ExpiresAt:  expiresAt,
+				LastUsedAt: lastUsedAt,
+			},
+		}
+	}
+
+	return &accessTokenServer{
+		tokens: []*AccessToken{
+			token("expiring", now.Add(time.Hour).Format(time.RFC3339), now.Format(time.RFC3339), ""),
+			token("expired", now.Add(-time.Hour).Format(time.RFC3339), now.Format(time.RFC3339), ""),
+			token("unused", "", "", ""),
+			token("valid", now.Add(90*24*time.Hour).Format(time.RFC3339), now.Format(time.RFC3339), ""),
+			token("revoked", now.Add(time.Hour).Format(time.RFC3339), "", now.Format(time.RFC3339)),
+		},
+	}
+}
+
+func (s *accessTokenServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case r.Method == "GET" && r.URL.Path == "/users/me/access_tokens":
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"total": len(s.tokens), "items": s.tokens})
+	case r.Method == "POST" && r.URL.Path == "/users/me/access_tokens":
+		var accessToken AccessToken
+		_ = json.NewDecoder(r.Body).Decode(&accessToken)
+		s.created = append(s.created, &accessToken)
+
+		id := "new-" + strings.ReplaceAll(accessToken.Name, " ", "-")
+		accessToken.Sys = &Sys{ID: id, CreatedAt: time.Now().UTC().Format(time.RFC3339)}
+		accessToken.Token = "CFPAT-" + id
+		w.WriteHeader(201)
+		_ = json.NewEncoder(w).Encode(accessToken)
+	case r.Method == "PUT" && strings.HasSuffix(r.URL.Path, "/revoked"):
+		id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/users/me/access_tokens/"), "/revoked")
+		s.revoked = append(s.revoked, id)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"sys": map[string]string{"id": id}})
+	default:
+		w.WriteHeader(404)
+	}
+}
+
+func TestAccessTokensService_Audit(t *testing.T) {
+	assertions := assert.New(t)
+
+	server := httptest.NewServer(newAccessTokenServer())
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	audits, err := cma.AccessTokens.Audit(context.Background(), 7*24*time.Hour)
+	assertions.Nil(err)
+	assertions.Equal(4, len(audits))
+
+	byID := map[string]AccessTokenAudit{}
+	for _, audit := range audits {
+		byID[audit.AccessToken.Sys.ID] = audit
+	}
+
+	assertions.True(byID["expiring"].ExpiresSoon)
+	assertions.False(byID["expiring"].NeverUsed)
+	assertions.True(byID["expired"].Expired)
+	assertions.False(byID["expired"].ExpiresSoon)
+	assertions.True(byID["unused"].NeverUsed)
+	assertions.False(byID["unused"].NeedsRotation())
+	assertions.False(byID["valid"].NeedsRotation())
+}
+
+func TestAccessTokensService_RotateExpiring(t *testing.T) {
+	assertions := assert.New(t)
+
+	fake := newAccessTokenServer()
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	vault := map[string]string{}
+	sink := SecretSinkFunc(func(ctx context.Context, accessToken *AccessToken) error {
+		vault[accessToken.Name] = accessToken.Token
+		return nil
+	})
+
+	rotated, err := cma.AccessTokens.RotateExpiring(context.Background(), 7*24*time.Hour, 30*24*time.Hour, sink)
+	assertions.Nil(err)
+	assertions.Equal(2, len(rotated))
+	assertions.Equal(map[string]string{
+		"token expiring": "CFPAT-new-token-expiring",
+		"token expired":  "CFPAT-new-token-expired",
+	}, vault)
+	assertions.Equal([]string{"expiring", "expired"}, fake.revoked)
+	assertions.Equal(30*24*60*60, fake.created[0].ExpiresIn)
+	assertions.Equal([]string{"content_management_manage"}, fake.created[0].Scopes)
+}
+
+func TestAccessTokensService_Rotate_SinkFailed(t *testing.T) {
+	assertions := assert.New(t)
+
+	fake := newAccessTokenServer()
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	sinkErr := errors.New("vault sealed")
+	sink := SecretSinkFunc(func(ctx context.Context, accessToken *AccessToken) error {
+		return sinkErr
+	})
+
+	_, err := cma.AccessTokens.Rotate(context.Background(), fake.tokens[0], 0, sink)
+	assertions.True(errors.Is(err, sinkErr))
+	assertions.Equal([]string{"new-token-expiring"}, fake.revoked)
+	assertions.Equal(0, fake.created[0].ExpiresIn)
+}
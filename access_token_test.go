@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -90,7 +91,7 @@ func TestAccessTokensServiceGet_2(t *testing.T) {
 	cma.BaseURL = server.URL
 
 	_, err = cma.AccessTokens.Get(context.Background(), "hioj6879UYGIfyt654tyfFHG")
-	assertions.Nil(err)
+	assertions.NotNil(err)
 }
 
 func TestEntriesServiceCreate(t *testing.T) {
@@ -107,11 +108,11 @@ func TestEntriesServiceCreate(t *testing.T) {
 		err := json.NewDecoder(r.Body).Decode(&payload)
 		assertions.Nil(err)
 
-		name := payload["name"].(string)
-		revokedAt := payload["revokedAt"]
-
-		assertions.Equal("Example Access Token", name)
-		assertions.Equal(nil, revokedAt)
+		assertions.Equal(map[string]interface{}{
+			"name":      "Example Access Token",
+			"scopes":    []interface{}{"content_management_manage"},
+			"expiresIn": float64(3600),
+		}, payload)
 
 		w.WriteHeader(200)
 		_, _ = fmt.Fprintln(w, readTestData("access_token_1.json"))
@@ -131,6 +132,8 @@ func TestEntriesServiceCreate(t *testing.T) {
 		Scopes: []string{
 			"content_management_manage",
 		},
+		Token:     "secret",
+		ExpiresIn: 3600,
 	}
 
 	err = cma.AccessTokens.Create(context.Background(), accessToken)
@@ -147,10 +150,10 @@ func TestAccessTokensService_Revoke(t *testing.T) {
 
 		checkHeaders(r, assertions)
 
-		var payload map[string]interface{}
-		err := json.NewDecoder(r.Body).Decode(&payload)
+		// the token is revoked without a body
+		body, err := ioutil.ReadAll(r.Body)
 		assertions.Nil(err)
-		assertions.Equal("2020-03-25T14:40:24Z", payload["revokedAt"])
+		assertions.Equal("", string(body))
 
 		w.WriteHeader(200)
 		_, _ = fmt.Fprintln(w, readTestData("access_token_updated.json"))
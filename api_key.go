@@ -28,9 +28,14 @@ type APIKeyPolicy struct {
 	Actions string `json:"actions,omitempty"`
 }
 
-// PreviewAPIKey model
+// PreviewAPIKey model, an api key only holds a link to it.
+// Use PreviewAPIKeysService to fetch the preview access token.
 type PreviewAPIKey struct {
-	Sys Sys `json:"sys,omitempty"`
+	Sys          Sys            `json:"sys,omitempty"`
+	Name         string         `json:"name,omitempty"`
+	Description  string         `json:"description,omitempty"`
+	AccessToken  string         `json:"accessToken,omitempty"`
+	Environments []Environments `json:"environments,omitempty"`
 }
 
 // Environments model
@@ -38,17 +43,45 @@ type Environments struct {
 	Sys Sys `json:"sys,omitempty"`
 }
 
+// NewEnvironmentLink returns the link to an environment used to scope api keys
+func NewEnvironmentLink(environmentID string) Environments {
+	return Environments{
+		Sys: Sys{
+			ID:       environmentID,
+			Type:     "Link",
+			LinkType: "Environment",
+		},
+	}
+}
+
 // MarshalJSON for custom json marshaling
 func (apiKey *APIKey) MarshalJSON() ([]byte, error) {
+	var environments []Environments
+	for _, env := range apiKey.Environments {
+		environments = append(environments, NewEnvironmentLink(env.Sys.ID))
+	}
+
 	return json.Marshal(&struct {
-		Name        string `json:"name"`
-		Description string `json:"description,omitempty"`
+		Name         string         `json:"name"`
+		Description  string         `json:"description,omitempty"`
+		Environments []Environments `json:"environments,omitempty"`
 	}{
-		Name:        apiKey.Name,
-		Description: apiKey.Description,
+		Name:         apiKey.Name,
+		Description:  apiKey.Description,
+		Environments: environments,
 	})
 }
 
+// EnvironmentIDs returns the ids of the environments the api key is scoped to
+func (apiKey *APIKey) EnvironmentIDs() []string {
+	ids := make([]string, 0, len(apiKey.Environments))
+	for _, env := range apiKey.Environments {
+		ids = append(ids, env.Sys.ID)
+	}
+
+	return ids
+}
+
 // GetVersion returns entity version
 func (apiKey *APIKey) GetVersion() int {
 	version := 1
@@ -0,0 +1,70 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+)
+
+// APIKeyRotation is the result of APIKeyService.Rotate
+type APIKeyRotation struct {
+	// Old is the rotated api key, it is deleted once the grace step succeeded
+	Old        *APIKey
+	OldPreview *PreviewAPIKey
+
+	// New is the replacement api key scoped to the same environments
+	New        *APIKey
+	NewPreview *PreviewAPIKey
+
+	// Deleted reports whether the old api key has been deleted
+	Deleted bool
+}
+
+// Rotate creates a replacement for the api key with the same name, description
+// and environments. The grace step is called with both keys, so the caller can
+// roll out the new tokens before the old key is deleted. If the grace step fails,
+// the old key is kept and the rotation is returned with the error. A nil grace
+// step deletes the old key right away.
+func (service *APIKeyService) Rotate(ctx context.Context, spaceID, apiKeyID string, grace func(ctx context.Context, rotation *APIKeyRotation) error) (*APIKeyRotation, error) {
+	old, err := service.Get(ctx, spaceID, apiKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	rotation := &APIKeyRotation{Old: old}
+	if old.PreviewAPIKey.Sys.ID != "" {
+		rotation.OldPreview, err = service.c.PreviewAPIKeys.GetForAPIKey(ctx, spaceID, old)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	replacement := &APIKey{
+		Name:         old.Name,
+		Description:  old.Description,
+		Environments: old.Environments,
+	}
+	if err := service.Upsert(ctx, spaceID, replacement); err != nil {
+		return nil, err
+	}
+	rotation.New = replacement
+
+	if replacement.PreviewAPIKey.Sys.ID != "" {
+		rotation.NewPreview, err = service.c.PreviewAPIKeys.GetForAPIKey(ctx, spaceID, replacement)
+		if err != nil {
+			return rotation, err
+		}
+	}
+
+	if grace != nil {
+		if err := grace(ctx, rotation); err != nil {
+			return rotation, fmt.Errorf("grace step of api key %s failed, keeping it: %w", apiKeyID, err)
+		}
+	}
+
+	if err := service.Delete(ctx, spaceID, old); err != nil {
+		return rotation, err
+	}
+	rotation.Deleted = true
+
+	return rotation, nil
+}
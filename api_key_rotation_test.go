@@ -0,0 +1,97 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRotationServer(assertions *assert.Assertions, created *map[string]interface{}, deleted *bool) *httptest.Server {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkHeaders(r, assertions)
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/spaces/"+spaceID+"/api_keys/exampleapikey":
+			_, _ = fmt.Fprintln(w, readTestData("api_key_1.json"))
+		case r.Method == "GET" && r.URL.Path == "/spaces/"+spaceID+"/preview_api_keys/1Mx3FqXX5XCJDtNpVW4BZI":
+			_, _ = fmt.Fprintln(w, readTestData("preview_api_key_1.json"))
+		case r.Method == "GET" && r.URL.Path == "/spaces/"+spaceID+"/preview_api_keys/newpreviewkey":
+			_, _ = fmt.Fprintln(w, `{"sys":{"id":"newpreviewkey"},"accessToken":"n3wpr3v13w"}`)
+		case r.Method == "POST" && r.URL.Path == "/spaces/"+spaceID+"/api_keys":
+			_ = json.NewDecoder(r.Body).Decode(created)
+			w.WriteHeader(201)
+			_, _ = fmt.Fprintln(w, `{
+				"sys": {"id": "newapikey", "version": 1},
+				"name": "Example API Key",
+				"accessToken": "n3w70k3n",
+				"environments": [{"sys": {"type": "Link", "linkType": "Environment", "id": "staging"}}],
+				"preview_api_key": {"sys": {"type": "Link", "linkType": "PreviewApiKey", "id": "newpreviewkey"}}
+			}`)
+		case r.Method == "DELETE" && r.URL.Path == "/spaces/"+spaceID+"/api_keys/exampleapikey":
+			assertions.Equal("1", r.Header.Get("X-Contentful-Version"))
+			*deleted = true
+			w.WriteHeader(204)
+		default:
+			assertions.Fail("unexpected request", r.Method+" "+r.URL.Path)
+			w.WriteHeader(404)
+		}
+	})
+
+	return httptest.NewServer(handler)
+}
+
+func TestAPIKeyService_Rotate(t *testing.T) {
+	assertions := assert.New(t)
+
+	var created map[string]interface{}
+	deleted := false
+	server := newRotationServer(assertions, &created, &deleted)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	rotation, err := cma.APIKeys.Rotate(context.Background(), spaceID, "exampleapikey", func(ctx context.Context, rotation *APIKeyRotation) error {
+		assertions.False(deleted)
+		assertions.Equal("b4c0n73n7fu1", rotation.Old.AccessToken)
+		assertions.Equal("n3w70k3n", rotation.New.AccessToken)
+		return nil
+	})
+	assertions.Nil(err)
+	assertions.True(deleted)
+	assertions.True(rotation.Deleted)
+	assertions.Equal("pr3v13w70k3n", rotation.OldPreview.AccessToken)
+	assertions.Equal("n3wpr3v13w", rotation.NewPreview.AccessToken)
+
+	assertions.Equal("Example API Key", created["name"])
+	assertions.Equal(1, len(created["environments"].([]interface{})))
+}
+
+func TestAPIKeyService_Rotate_GraceFailed(t *testing.T) {
+	assertions := assert.New(t)
+
+	var created map[string]interface{}
+	deleted := false
+	server := newRotationServer(assertions, &created, &deleted)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	graceErr := errors.New("deployment failed")
+	rotation, err := cma.APIKeys.Rotate(context.Background(), spaceID, "exampleapikey", func(ctx context.Context, rotation *APIKeyRotation) error {
+		return graceErr
+	})
+	assertions.True(errors.Is(err, graceErr))
+	assertions.False(deleted)
+	assertions.False(rotation.Deleted)
+	assertions.Equal("newapikey", rotation.New.Sys.ID)
+}
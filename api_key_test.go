@@ -105,6 +105,16 @@ func TestAPIKeyService_Upsert_Create(t *testing.T) {
 		err := json.NewDecoder(r.Body).Decode(&payload)
 		assertions.Nil(err)
 		assertions.Equal("Example API Key", payload["name"])
+		assertions.Equal([]interface{}{
+			map[string]interface{}{
+				"sys": map[string]interface{}{
+					"id":       "master",
+					"type":     "Link",
+					"linkType": "Environment",
+				},
+			},
+		}, payload["environments"])
+		assertions.Nil(payload["accessToken"])
 
 		w.WriteHeader(201)
 		_, _ = fmt.Fprintln(w, readTestData("api_key_1.json"))
@@ -0,0 +1,47 @@
+package contentful
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"net/http"
+)
+
+// AppAccessTokensService service
+type AppAccessTokensService service
+
+// AppAccessToken model, the token acts on behalf of the app in one environment
+type AppAccessToken struct {
+	Sys   *Sys   `json:"sys,omitempty"`
+	Token string `json:"token"`
+}
+
+// Create exchanges a JWT signed with an app key for an app access token.
+// The request is authorized with the JWT instead of the client token.
+func (service *AppAccessTokensService) Create(ctx context.Context, env *Environment, appDefinitionID, signedToken string) (*AppAccessToken, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s/access_tokens", env.Sys.Space.Sys.ID, env.Sys.ID, appDefinitionID)
+
+	req, err := service.c.newRequest(ctx, http.MethodPost, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+signedToken)
+
+	var token AppAccessToken
+	if err := service.c.do(req, &token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// Issue signs a JWT with the private key of the app key and exchanges it for an app access token
+func (service *AppAccessTokensService) Issue(ctx context.Context, env *Environment, appDefinitionID, keyID string, privateKey *rsa.PrivateKey) (*AppAccessToken, error) {
+	signed, err := SignAppToken(privateKey, keyID, appDefinitionID, maxAppTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.Create(ctx, env, appDefinitionID, signed)
+}
@@ -0,0 +1,69 @@
+package contentful
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppAccessTokensService_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/app_installations/app_definition_id/access_tokens")
+		assertions.Equal("Bearer signed-jwt", r.Header.Get("Authorization"))
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, `{"sys":{"type":"AppAccessToken"},"token":"app-token"}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	token, err := cma.AppAccessTokens.Create(context.Background(), env, "app_definition_id", "signed-jwt")
+	assertions.Nil(err)
+	assertions.Equal("app-token", token.Token)
+}
+
+func TestAppAccessTokensService_Issue(t *testing.T) {
+	assertions := assert.New(t)
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	assertions.Nil(err)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/app_installations/app_definition_id/access_tokens")
+
+		jwt := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		assertions.Equal(3, len(strings.Split(jwt, ".")))
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, `{"sys":{"type":"AppAccessToken"},"token":"app-token"}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	token, err := cma.AppAccessTokens.Issue(context.Background(), env, "app_definition_id", "key_id", key)
+	assertions.Nil(err)
+	assertions.Equal("app-token", token.Token)
+}
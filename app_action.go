@@ -0,0 +1,171 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// AppActionsService service
+type AppActionsService service
+
+// noinspection GoUnusedConst
+const (
+	AppActionTypeEndpoint = "endpoint"
+	AppActionTypeFunction = "function"
+)
+
+// noinspection GoUnusedConst
+const (
+	AppActionCategoryCustom         = "Custom"
+	AppActionCategoryNotificationV1 = "Notification.v1.0"
+	AppActionCategoryEntriesV1      = "Entries.v1.0"
+)
+
+// noinspection GoUnusedConst
+const (
+	AppActionCallStatusProcessing = "processing"
+	AppActionCallStatusSucceeded  = "succeeded"
+	AppActionCallStatusFailed     = "failed"
+)
+
+// AppAction model
+type AppAction struct {
+	Sys        *Sys                     `json:"sys,omitempty"`
+	Name       string                   `json:"name"`
+	URL        string                   `json:"url,omitempty"`
+	Type       string                   `json:"type,omitempty"`
+	Category   string                   `json:"category,omitempty"`
+	Parameters []AppParameterDefinition `json:"parameters,omitempty"`
+}
+
+// AppActionCall model, the result of calling an app action
+type AppActionCall struct {
+	Sys    *Sys           `json:"sys,omitempty"`
+	Status string         `json:"status,omitempty"`
+	Result any            `json:"result,omitempty"`
+	Error  map[string]any `json:"error,omitempty"`
+}
+
+// List returns the actions of an app definition
+func (service *AppActionsService) List(ctx context.Context, organizationID, appDefinitionID string) (*Collection[AppAction], error) {
+	path := fmt.Sprintf("/organizations/%s/app_definitions/%s/actions", organizationID, appDefinitionID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := newCollection[AppAction](nil, service.c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return col, nil
+}
+
+// Get returns a single app action
+func (service *AppActionsService) Get(ctx context.Context, organizationID, appDefinitionID, actionID string) (*AppAction, error) {
+	path := fmt.Sprintf("/organizations/%s/app_definitions/%s/actions/%s", organizationID, appDefinitionID, actionID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var action AppAction
+	if err := service.c.do(req, &action); err != nil {
+		return nil, err
+	}
+
+	return &action, nil
+}
+
+// Upsert updates or creates a new app action
+func (service *AppActionsService) Upsert(ctx context.Context, organizationID, appDefinitionID string, action *AppAction) error {
+	bytesArray, err := json.Marshal(AppAction{
+		Name:       action.Name,
+		URL:        action.URL,
+		Type:       action.Type,
+		Category:   action.Category,
+		Parameters: action.Parameters,
+	})
+	if err != nil {
+		return err
+	}
+
+	var path string
+	var method string
+
+	if action.Sys != nil && action.Sys.ID != "" {
+		path = fmt.Sprintf("/organizations/%s/app_definitions/%s/actions/%s", organizationID, appDefinitionID, action.Sys.ID)
+		method = http.MethodPut
+	} else {
+		path = fmt.Sprintf("/organizations/%s/app_definitions/%s/actions", organizationID, appDefinitionID)
+		method = http.MethodPost
+	}
+
+	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, action)
+}
+
+// Delete the app action
+func (service *AppActionsService) Delete(ctx context.Context, organizationID, appDefinitionID, actionID string) error {
+	path := fmt.Sprintf("/organizations/%s/app_definitions/%s/actions/%s", organizationID, appDefinitionID, actionID)
+
+	req, err := service.c.newRequest(ctx, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, nil)
+}
+
+// Call invokes the action of an app installed in the environment
+func (service *AppActionsService) Call(ctx context.Context, env *Environment, appDefinitionID, actionID string, parameters map[string]any) (*AppActionCall, error) {
+	if parameters == nil {
+		parameters = map[string]any{}
+	}
+
+	bytesArray, err := json.Marshal(map[string]any{"parameters": parameters})
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s/actions/%s/calls", env.Sys.Space.Sys.ID, env.Sys.ID, appDefinitionID, actionID)
+
+	req, err := service.c.newRequest(ctx, http.MethodPost, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return nil, err
+	}
+
+	var call AppActionCall
+	if err := service.c.do(req, &call); err != nil {
+		return nil, err
+	}
+
+	return &call, nil
+}
+
+// GetCall returns the current state of an app action call
+func (service *AppActionsService) GetCall(ctx context.Context, env *Environment, appDefinitionID, actionID, callID string) (*AppActionCall, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s/actions/%s/calls/%s", env.Sys.Space.Sys.ID, env.Sys.ID, appDefinitionID, actionID, callID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var call AppActionCall
+	if err := service.c.do(req, &call); err != nil {
+		return nil, err
+	}
+
+	return &call, nil
+}
@@ -0,0 +1,212 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppActionsService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/organizations/organization_id/app_definitions/app_definition_id/actions")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("app_action.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	collection, err := cma.AppActions.List(context.Background(), "organization_id", "app_definition_id")
+	assertions.Nil(err)
+	assertions.Equal(1, len(collection.Items))
+	assertions.Equal("Translate", collection.Items[0].Name)
+	assertions.Equal(AppActionTypeEndpoint, collection.Items[0].Type)
+}
+
+func TestAppActionsService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/organizations/organization_id/app_definitions/app_definition_id/actions/action_id")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("app_action_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	action, err := cma.AppActions.Get(context.Background(), "organization_id", "app_definition_id", "action_id")
+	assertions.Nil(err)
+	assertions.Equal("action_id", action.Sys.ID)
+	assertions.Equal(AppActionCategoryCustom, action.Category)
+	assertions.Equal("locale", action.Parameters[0].ID)
+}
+
+func TestAppActionsService_Upsert_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		assertions.Equal(r.URL.Path, "/organizations/organization_id/app_definitions/app_definition_id/actions")
+		checkHeaders(r, assertions)
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("Translate", payload["name"])
+		assertions.Equal("endpoint", payload["type"])
+		assertions.Nil(payload["sys"])
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, readTestData("app_action_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	action := &AppAction{
+		Name:     "Translate",
+		URL:      "https://example.com/translate",
+		Type:     AppActionTypeEndpoint,
+		Category: AppActionCategoryCustom,
+	}
+
+	err := cma.AppActions.Upsert(context.Background(), "organization_id", "app_definition_id", action)
+	assertions.Nil(err)
+	assertions.Equal("action_id", action.Sys.ID)
+}
+
+func TestAppActionsService_Upsert_Update(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "PUT")
+		assertions.Equal(r.URL.Path, "/organizations/organization_id/app_definitions/app_definition_id/actions/action_id")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("app_action_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	action := &AppAction{Sys: &Sys{ID: "action_id"}, Name: "Translate"}
+
+	err := cma.AppActions.Upsert(context.Background(), "organization_id", "app_definition_id", action)
+	assertions.Nil(err)
+	assertions.Equal("https://example.com/translate", action.URL)
+}
+
+func TestAppActionsService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "DELETE")
+		assertions.Equal(r.URL.Path, "/organizations/organization_id/app_definitions/app_definition_id/actions/action_id")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(204)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	err := cma.AppActions.Delete(context.Background(), "organization_id", "app_definition_id", "action_id")
+	assertions.Nil(err)
+}
+
+func TestAppActionsService_Call(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/app_installations/app_definition_id/actions/action_id/calls")
+		checkHeaders(r, assertions)
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal(map[string]any{"parameters": map[string]any{"locale": "de"}}, payload)
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, readTestData("app_action_call.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	call, err := cma.AppActions.Call(context.Background(), env, "app_definition_id", "action_id", map[string]any{"locale": "de"})
+	assertions.Nil(err)
+	assertions.Equal("call_id", call.Sys.ID)
+	assertions.Equal(AppActionCallStatusSucceeded, call.Status)
+	assertions.Equal(map[string]any{"translated": "Hallo Welt"}, call.Result)
+}
+
+func TestAppActionsService_GetCall(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/app_installations/app_definition_id/actions/action_id/calls/call_id")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("app_action_call.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	call, err := cma.AppActions.GetCall(context.Background(), env, "app_definition_id", "action_id", "call_id")
+	assertions.Nil(err)
+	assertions.Equal(AppActionCallStatusSucceeded, call.Status)
+}
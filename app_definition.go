@@ -13,17 +13,97 @@ import (
 // AppDefinitionsService service
 type AppDefinitionsService service
 
+// noinspection GoUnusedConst
+const (
+	AppLocationAppConfig    = "app-config"
+	AppLocationEntrySidebar = "entry-sidebar"
+	AppLocationEntryField   = "entry-field"
+	AppLocationEntryEditor  = "entry-editor"
+	AppLocationDialog       = "dialog"
+	AppLocationPage         = "page"
+	AppLocationHome         = "home"
+)
+
+// noinspection GoUnusedConst
+const (
+	AppParameterTypeSymbol  = "Symbol"
+	AppParameterTypeEnum    = "Enum"
+	AppParameterTypeNumber  = "Number"
+	AppParameterTypeBoolean = "Boolean"
+)
+
 // AppDefinition model
 type AppDefinition struct {
-	Sys       *Sys        `json:"sys"`
-	Name      string      `json:"name"`
-	SRC       string      `json:"src"`
-	Locations []Locations `json:"locations"`
+	Sys        *Sys           `json:"sys"`
+	Name       string         `json:"name"`
+	SRC        string         `json:"src,omitempty"`
+	Locations  []Locations    `json:"locations"`
+	Parameters *AppParameters `json:"parameters,omitempty"`
 }
 
 // Locations model
 type Locations struct {
 	Location string `json:"location"`
+
+	// FieldTypes the app can be used for, only for the entry-field location
+	FieldTypes []AppFieldType `json:"fieldTypes,omitempty"`
+
+	// NavigationItem links the app in the main navigation, only for the page location
+	NavigationItem *AppNavigationItem `json:"navigationItem,omitempty"`
+}
+
+// AppFieldType model
+type AppFieldType struct {
+	Type     string             `json:"type"`
+	LinkType string             `json:"linkType,omitempty"`
+	Items    *AppFieldTypeItems `json:"items,omitempty"`
+}
+
+// AppFieldTypeItems model
+type AppFieldTypeItems struct {
+	Type     string `json:"type"`
+	LinkType string `json:"linkType,omitempty"`
+}
+
+// AppNavigationItem model
+type AppNavigationItem struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// AppParameters model with the definitions of the instance and installation parameters
+type AppParameters struct {
+	Instance     []AppParameterDefinition `json:"instance,omitempty"`
+	Installation []AppParameterDefinition `json:"installation,omitempty"`
+}
+
+// AppParameterDefinition model
+type AppParameterDefinition struct {
+	ID          string                        `json:"id"`
+	Name        string                        `json:"name"`
+	Description string                        `json:"description,omitempty"`
+	Type        string                        `json:"type"`
+	Required    bool                          `json:"required,omitempty"`
+	Default     any                           `json:"default,omitempty"`
+	Options     []any                         `json:"options,omitempty"`
+	Labels      *AppParameterDefinitionLabels `json:"labels,omitempty"`
+}
+
+// AppParameterDefinitionLabels model
+type AppParameterDefinitionLabels struct {
+	Empty string `json:"empty,omitempty"`
+	True  string `json:"true,omitempty"`
+	False string `json:"false,omitempty"`
+}
+
+// NewEntryFieldLocation returns an entry-field location for the given field types
+func NewEntryFieldLocation(fieldTypes ...AppFieldType) Locations {
+	return Locations{Location: AppLocationEntryField, FieldTypes: fieldTypes}
+}
+
+// NewPageLocation returns a page location, a nil navigation item hides the page from the navigation
+func NewPageLocation(navigationItem *AppNavigationItem) Locations {
+	return Locations{Location: AppLocationPage, NavigationItem: navigationItem}
 }
 
 // GetVersion returns entity version
@@ -65,7 +145,7 @@ func (service *AppDefinitionsService) Get(ctx context.Context, organizationID, a
 	}
 
 	var definition AppDefinition
-	if ok := service.c.do(req, &definition); ok != nil {
+	if err := service.c.do(req, &definition); err != nil {
 		return nil, err
 	}
 
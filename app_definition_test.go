@@ -93,7 +93,7 @@ func TestAppDefinitionsService_Get_2(t *testing.T) {
 	cma.BaseURL = server.URL
 
 	_, err = cma.AppDefinitions.Get(context.Background(), "organization_id", "app_definition_id")
-	assertions.Nil(err)
+	assertions.NotNil(err)
 }
 
 func TestAppDefinitionsService_Upsert_Create(t *testing.T) {
@@ -203,3 +203,107 @@ func TestAppDefinitionsService_Delete(t *testing.T) {
 	err = cma.AppDefinitions.Delete(context.Background(), "organization_id", definition.Sys.ID)
 	assertions.Nil(err)
 }
+
+func TestAppDefinitionsService_Get_LocationsAndParameters(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/organizations/organization_id/app_definitions/app_definition_id")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("app_definition_locations.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	definition, err := cma.AppDefinitions.Get(context.Background(), "organization_id", "app_definition_id")
+	assertions.Nil(err)
+	assertions.Equal(3, len(definition.Locations))
+
+	field := definition.Locations[1]
+	assertions.Equal(AppLocationEntryField, field.Location)
+	assertions.Equal(2, len(field.FieldTypes))
+	assertions.Equal("Symbol", field.FieldTypes[0].Type)
+	assertions.Equal("Entry", field.FieldTypes[1].Items.LinkType)
+
+	page := definition.Locations[2]
+	assertions.Equal(AppLocationPage, page.Location)
+	assertions.Equal("/palettes", page.NavigationItem.Path)
+
+	assertions.Equal(1, len(definition.Parameters.Instance))
+	assertions.Equal(AppParameterTypeEnum, definition.Parameters.Instance[0].Type)
+	assertions.Equal([]any{"hex", "rgb"}, definition.Parameters.Instance[0].Options)
+	assertions.Equal("hex", definition.Parameters.Instance[0].Default)
+
+	assertions.Equal(2, len(definition.Parameters.Installation))
+	assertions.True(definition.Parameters.Installation[0].Required)
+	assertions.Equal("On", definition.Parameters.Installation[1].Labels.True)
+}
+
+func TestAppDefinitionsService_Upsert_LocationsAndParameters(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		assertions.Equal(r.RequestURI, "/organizations/organization_id/app_definitions")
+		checkHeaders(r, assertions)
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+
+		locations := payload["locations"].([]any)
+		assertions.Equal(2, len(locations))
+
+		field := locations[0].(map[string]any)
+		assertions.Equal("entry-field", field["location"])
+		assertions.Equal([]any{map[string]any{"type": "Symbol"}}, field["fieldTypes"])
+		assertions.Nil(field["navigationItem"])
+
+		page := locations[1].(map[string]any)
+		assertions.Equal("page", page["location"])
+		assertions.Equal(map[string]any{"name": "Palettes", "path": "/palettes"}, page["navigationItem"])
+
+		parameters := payload["parameters"].(map[string]any)
+		installation := parameters["installation"].([]any)
+		assertions.Equal("apiKey", installation[0].(map[string]any)["id"])
+		assertions.Nil(parameters["instance"])
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, readTestData("app_definition_locations.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	definition := &AppDefinition{
+		Name: "Color picker",
+		SRC:  "https://example.com/app.html",
+		Locations: []Locations{
+			NewEntryFieldLocation(AppFieldType{Type: "Symbol"}),
+			NewPageLocation(&AppNavigationItem{Name: "Palettes", Path: "/palettes"}),
+		},
+		Parameters: &AppParameters{
+			Installation: []AppParameterDefinition{
+				{ID: "apiKey", Name: "API key", Type: AppParameterTypeSymbol, Required: true},
+			},
+		},
+	}
+
+	err := cma.AppDefinitions.Upsert(context.Background(), "organization_id", definition)
+	assertions.Nil(err)
+	assertions.Equal(2, definition.Sys.Version)
+}
@@ -0,0 +1,70 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// AppEventSubscriptionsService service
+type AppEventSubscriptionsService service
+
+// AppEventSubscription model, an app definition has at most one subscription
+type AppEventSubscription struct {
+	Sys       *Sys             `json:"sys,omitempty"`
+	TargetURL string           `json:"targetUrl"`
+	Topics    []string         `json:"topics"`
+	Filters   []map[string]any `json:"filters,omitempty"`
+}
+
+// Get returns the event subscription of the app definition
+func (service *AppEventSubscriptionsService) Get(ctx context.Context, organizationID, appDefinitionID string) (*AppEventSubscription, error) {
+	path := fmt.Sprintf("/organizations/%s/app_definitions/%s/event_subscription", organizationID, appDefinitionID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var subscription AppEventSubscription
+	if err := service.c.do(req, &subscription); err != nil {
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+// Upsert creates or replaces the event subscription of the app definition
+func (service *AppEventSubscriptionsService) Upsert(ctx context.Context, organizationID, appDefinitionID string, subscription *AppEventSubscription) error {
+	bytesArray, err := json.Marshal(AppEventSubscription{
+		TargetURL: subscription.TargetURL,
+		Topics:    subscription.Topics,
+		Filters:   subscription.Filters,
+	})
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/organizations/%s/app_definitions/%s/event_subscription", organizationID, appDefinitionID)
+
+	req, err := service.c.newRequest(ctx, http.MethodPut, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, subscription)
+}
+
+// Delete the event subscription of the app definition
+func (service *AppEventSubscriptionsService) Delete(ctx context.Context, organizationID, appDefinitionID string) error {
+	path := fmt.Sprintf("/organizations/%s/app_definitions/%s/event_subscription", organizationID, appDefinitionID)
+
+	req, err := service.c.newRequest(ctx, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, nil)
+}
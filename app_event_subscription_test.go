@@ -0,0 +1,100 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppEventSubscriptionsService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/organizations/organization_id/app_definitions/app_definition_id/event_subscription")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("app_event_subscription.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	subscription, err := cma.AppEventSubscriptions.Get(context.Background(), "organization_id", "app_definition_id")
+	assertions.Nil(err)
+	assertions.Equal("https://example.com/events", subscription.TargetURL)
+	assertions.Equal([]string{"Entry.publish", "Entry.unpublish"}, subscription.Topics)
+	assertions.Equal(1, len(subscription.Filters))
+}
+
+func TestAppEventSubscriptionsService_Upsert(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "PUT")
+		assertions.Equal(r.URL.Path, "/organizations/organization_id/app_definitions/app_definition_id/event_subscription")
+		checkHeaders(r, assertions)
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("https://example.com/events", payload["targetUrl"])
+		assertions.Equal([]any{"Entry.publish", "Entry.unpublish"}, payload["topics"])
+		assertions.Nil(payload["sys"])
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("app_event_subscription.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	subscription := &AppEventSubscription{
+		TargetURL: "https://example.com/events",
+		Topics:    []string{"Entry.publish", "Entry.unpublish"},
+	}
+
+	err := cma.AppEventSubscriptions.Upsert(context.Background(), "organization_id", "app_definition_id", subscription)
+	assertions.Nil(err)
+	assertions.Equal("AppEventSubscription", subscription.Sys.Type)
+	assertions.Equal(1, len(subscription.Filters))
+}
+
+func TestAppEventSubscriptionsService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "DELETE")
+		assertions.Equal(r.URL.Path, "/organizations/organization_id/app_definitions/app_definition_id/event_subscription")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(204)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	err := cma.AppEventSubscriptions.Delete(context.Background(), "organization_id", "app_definition_id")
+	assertions.Nil(err)
+}
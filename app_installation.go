@@ -15,8 +15,17 @@ type AppInstallationsService service
 
 // AppInstallation model
 type AppInstallation struct {
-	Sys        *Sys              `json:"sys"`
-	Parameters map[string]string `json:"parameters"`
+	Sys        *Sys           `json:"sys"`
+	Parameters map[string]any `json:"parameters"`
+}
+
+// AppDefinitionID returns the id of the installed app definition
+func (appInstallation *AppInstallation) AppDefinitionID() string {
+	if appInstallation.Sys == nil || appInstallation.Sys.AppDefinition == nil || appInstallation.Sys.AppDefinition.Sys == nil {
+		return ""
+	}
+
+	return appInstallation.Sys.AppDefinition.Sys.ID
 }
 
 // GetVersion returns entity version
@@ -30,8 +39,8 @@ func (appInstallation *AppInstallation) GetVersion() int {
 }
 
 // List returns an app installations collection
-func (service *AppInstallationsService) List(ctx context.Context, spaceID string, query *Query) (*Collection[AppInstallation], error) {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/app_installations", spaceID, service.c.Environment)
+func (service *AppInstallationsService) List(ctx context.Context, env *Environment, query *Query) (*Collection[AppInstallation], error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/app_installations", env.Sys.Space.Sys.ID, env.Sys.ID)
 
 	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
@@ -47,8 +56,8 @@ func (service *AppInstallationsService) List(ctx context.Context, spaceID string
 }
 
 // Get returns a single app installation
-func (service *AppInstallationsService) Get(ctx context.Context, spaceID, appInstallationID string) (*AppInstallation, error) {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", spaceID, service.c.Environment, appInstallationID)
+func (service *AppInstallationsService) Get(ctx context.Context, env *Environment, appInstallationID string) (*AppInstallation, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", env.Sys.Space.Sys.ID, env.Sys.ID, appInstallationID)
 	query := url.Values{}
 	method := "GET"
 
@@ -58,7 +67,7 @@ func (service *AppInstallationsService) Get(ctx context.Context, spaceID, appIns
 	}
 
 	var installation AppInstallation
-	if ok := service.c.do(req, &installation); ok != nil {
+	if err := service.c.do(req, &installation); err != nil {
 		return nil, err
 	}
 
@@ -66,7 +75,7 @@ func (service *AppInstallationsService) Get(ctx context.Context, spaceID, appIns
 }
 
 // Upsert updates or creates a new app installation
-func (service *AppInstallationsService) Upsert(ctx context.Context, spaceID, appInstallationID string, installation *AppInstallation) error {
+func (service *AppInstallationsService) Upsert(ctx context.Context, env *Environment, appInstallationID string, installation *AppInstallation) error {
 	bytesArray, err := json.Marshal(installation)
 	if err != nil {
 		return err
@@ -76,10 +85,10 @@ func (service *AppInstallationsService) Upsert(ctx context.Context, spaceID, app
 	var method string
 
 	if appInstallationID != "" {
-		path = fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", spaceID, service.c.Environment, appInstallationID)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", env.Sys.Space.Sys.ID, env.Sys.ID, appInstallationID)
 		method = "PUT"
 	} else {
-		path = fmt.Sprintf("/spaces/%s/environments/%s/app_installations", spaceID, service.c.Environment)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/app_installations", env.Sys.Space.Sys.ID, env.Sys.ID)
 		method = "POST"
 	}
 
@@ -94,8 +103,8 @@ func (service *AppInstallationsService) Upsert(ctx context.Context, spaceID, app
 }
 
 // Delete the app installation
-func (service *AppInstallationsService) Delete(ctx context.Context, spaceID, appInstallationID string) error {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", spaceID, service.c.Environment, appInstallationID)
+func (service *AppInstallationsService) Delete(ctx context.Context, env *Environment, appInstallationID string) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", env.Sys.Space.Sys.ID, env.Sys.ID, appInstallationID)
 	method := "DELETE"
 
 	req, err := service.c.newRequest(ctx, method, path, nil, nil)
@@ -17,7 +17,7 @@ func TestAppInstallationsService_List(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/app_installations")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/app_installations")
 
 		checkHeaders(r, assertions)
 
@@ -33,7 +33,7 @@ func TestAppInstallationsService_List(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	collection, err := cma.AppInstallations.List(context.Background(), spaceID, nil)
+	collection, err := cma.AppInstallations.List(context.Background(), env, nil)
 	assertions.Nil(err)
 
 	installation := collection.Items
@@ -47,7 +47,7 @@ func TestAppInstallationsService_Get(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/app_installations/app_definition_id")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/app_installations/app_definition_id")
 
 		checkHeaders(r, assertions)
 
@@ -63,7 +63,7 @@ func TestAppInstallationsService_Get(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	installation, err := cma.AppInstallations.Get(context.Background(), spaceID, "app_definition_id")
+	installation, err := cma.AppInstallations.Get(context.Background(), env, "app_definition_id")
 	assertions.Nil(err)
 	assertions.Equal("world", installation.Parameters["hello"])
 }
@@ -74,7 +74,7 @@ func TestAppInstallationsService_Get_2(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/app_installations/app_definition_id")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/app_installations/app_definition_id")
 
 		checkHeaders(r, assertions)
 
@@ -90,8 +90,8 @@ func TestAppInstallationsService_Get_2(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	_, err = cma.AppInstallations.Get(context.Background(), spaceID, "app_definition_id")
-	assertions.Nil(err)
+	_, err = cma.AppInstallations.Get(context.Background(), env, "app_definition_id")
+	assertions.NotNil(err)
 }
 
 func TestAppInstallationsService_Upsert_Create(t *testing.T) {
@@ -99,7 +99,7 @@ func TestAppInstallationsService_Upsert_Create(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "POST")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/app_installations")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/app_installations")
 		checkHeaders(r, assertions)
 
 		var payload map[string]interface{}
@@ -121,12 +121,12 @@ func TestAppInstallationsService_Upsert_Create(t *testing.T) {
 	cma.BaseURL = server.URL
 
 	installation := &AppInstallation{
-		Parameters: map[string]string{
+		Parameters: map[string]any{
 			"hello": "world",
 		},
 	}
 
-	err := cma.AppInstallations.Upsert(context.Background(), spaceID, "", installation)
+	err := cma.AppInstallations.Upsert(context.Background(), env, "", installation)
 	assertions.Nil(err)
 	assertions.Equal("world", installation.Parameters["hello"])
 }
@@ -137,7 +137,7 @@ func TestAppInstallationsService_Upsert_Update(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/app_installations/app_definition_id")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/app_installations/app_definition_id")
 		checkHeaders(r, assertions)
 
 		var payload map[string]interface{}
@@ -163,7 +163,7 @@ func TestAppInstallationsService_Upsert_Update(t *testing.T) {
 
 	installation.Parameters["lorum"] = "ipsum"
 
-	err = cma.AppInstallations.Upsert(context.Background(), spaceID, "app_definition_id", installation)
+	err = cma.AppInstallations.Upsert(context.Background(), env, "app_definition_id", installation)
 	assertions.Nil(err)
 	assertions.Equal("ipsum", installation.Parameters["lorum"])
 }
@@ -174,7 +174,7 @@ func TestAppInstallationsService_Delete(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "DELETE")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/app_installations/app_definition_id")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/app_installations/app_definition_id")
 		checkHeaders(r, assertions)
 
 		w.WriteHeader(200)
@@ -188,6 +188,6 @@ func TestAppInstallationsService_Delete(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	err = cma.AppInstallations.Delete(context.Background(), spaceID, "app_definition_id")
+	err = cma.AppInstallations.Delete(context.Background(), env, "app_definition_id")
 	assertions.Nil(err)
 }
@@ -0,0 +1,184 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// AppKeysService service
+type AppKeysService service
+
+// AppKey model, the key id is its sys id
+type AppKey struct {
+	Sys       *Sys             `json:"sys,omitempty"`
+	JWK       *JWK             `json:"jwk,omitempty"`
+	Generated *AppKeyGenerated `json:"generated,omitempty"`
+}
+
+// JWK model of a public key
+type JWK struct {
+	Alg string   `json:"alg,omitempty"`
+	Kty string   `json:"kty,omitempty"`
+	Use string   `json:"use,omitempty"`
+	X5C []string `json:"x5c,omitempty"`
+	Kid string   `json:"kid,omitempty"`
+	X5T string   `json:"x5t,omitempty"`
+}
+
+// AppKeyGenerated holds the private key of a key generated by contentful,
+// it is only returned once when the key is created
+type AppKeyGenerated struct {
+	PrivateKey string `json:"privateKey"`
+}
+
+// maxAppTokenTTL is the longest lifetime contentful accepts for app tokens
+const maxAppTokenTTL = 10 * time.Minute
+
+// List returns the keys of an app definition
+func (service *AppKeysService) List(ctx context.Context, organizationID, appDefinitionID string) (*Collection[AppKey], error) {
+	path := fmt.Sprintf("/organizations/%s/app_definitions/%s/keys", organizationID, appDefinitionID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := newCollection[AppKey](nil, service.c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return col, nil
+}
+
+// Get returns a single app key
+func (service *AppKeysService) Get(ctx context.Context, organizationID, appDefinitionID, keyID string) (*AppKey, error) {
+	path := fmt.Sprintf("/organizations/%s/app_definitions/%s/keys/%s", organizationID, appDefinitionID, keyID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var key AppKey
+	if err := service.c.do(req, &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// Generate lets contentful generate a key pair. The private key is only
+// returned in the response, store it safely.
+func (service *AppKeysService) Generate(ctx context.Context, organizationID, appDefinitionID string) (*AppKey, error) {
+	return service.create(ctx, organizationID, appDefinitionID, map[string]any{"generate": true})
+}
+
+// Create uploads the public key of a key pair generated by the caller
+func (service *AppKeysService) Create(ctx context.Context, organizationID, appDefinitionID string, jwk *JWK) (*AppKey, error) {
+	return service.create(ctx, organizationID, appDefinitionID, map[string]any{"jwk": jwk})
+}
+
+func (service *AppKeysService) create(ctx context.Context, organizationID, appDefinitionID string, payload map[string]any) (*AppKey, error) {
+	bytesArray, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/organizations/%s/app_definitions/%s/keys", organizationID, appDefinitionID)
+
+	req, err := service.c.newRequest(ctx, http.MethodPost, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return nil, err
+	}
+
+	var key AppKey
+	if err := service.c.do(req, &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// Delete the app key
+func (service *AppKeysService) Delete(ctx context.Context, organizationID, appDefinitionID, keyID string) error {
+	path := fmt.Sprintf("/organizations/%s/app_definitions/%s/keys/%s", organizationID, appDefinitionID, keyID)
+
+	req, err := service.c.newRequest(ctx, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, nil)
+}
+
+// ParseAppPrivateKey parses a PEM encoded PKCS#1 or PKCS#8 RSA private key,
+// e.g. AppKeyGenerated.PrivateKey
+func ParseAppPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM encoded private key found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not a RSA key")
+	}
+
+	return rsaKey, nil
+}
+
+// SignAppToken returns a RS256 signed JWT issued by the app definition. Exchange it for
+// an app access token with AppAccessTokensService.Create. The ttl is capped at
+// ten minutes, the longest lifetime contentful accepts.
+func SignAppToken(privateKey *rsa.PrivateKey, keyID, appDefinitionID string, ttl time.Duration) (string, error) {
+	if ttl <= 0 || ttl > maxAppTokenTTL {
+		ttl = maxAppTokenTTL
+	}
+
+	now := time.Now()
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": keyID})
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := json.Marshal(map[string]any{
+		"iss": appDefinitionID,
+		"iat": now.Unix(),
+		"exp": now.Add(ttl).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
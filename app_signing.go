@@ -0,0 +1,190 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AppSigningSecretsService service
+type AppSigningSecretsService service
+
+// AppSigningSecret model, contentful only returns the redacted value
+type AppSigningSecret struct {
+	Sys           *Sys   `json:"sys,omitempty"`
+	Value         string `json:"value,omitempty"`
+	RedactedValue string `json:"redactedValue,omitempty"`
+}
+
+// noinspection GoUnusedConst
+const (
+	AppSignatureHeader     = "x-contentful-signature"
+	AppSignedHeadersHeader = "x-contentful-signed-headers"
+	AppTimestampHeader     = "x-contentful-timestamp"
+)
+
+// DefaultAppRequestTTL is the maximum age of a signed request accepted by VerifyAppRequest
+const DefaultAppRequestTTL = 30 * time.Second
+
+// appRequestClockSkew is how far the timestamp of a signed request may be in the future
+const appRequestClockSkew = 5 * time.Second
+
+// Get returns the redacted signing secret of the app definition
+func (service *AppSigningSecretsService) Get(ctx context.Context, organizationID, appDefinitionID string) (*AppSigningSecret, error) {
+	path := fmt.Sprintf("/organizations/%s/app_definitions/%s/signing_secret", organizationID, appDefinitionID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var secret AppSigningSecret
+	if err := service.c.do(req, &secret); err != nil {
+		return nil, err
+	}
+
+	return &secret, nil
+}
+
+// Upsert sets the signing secret of the app definition
+func (service *AppSigningSecretsService) Upsert(ctx context.Context, organizationID, appDefinitionID, value string) (*AppSigningSecret, error) {
+	bytesArray, err := json.Marshal(AppSigningSecret{Value: value})
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/organizations/%s/app_definitions/%s/signing_secret", organizationID, appDefinitionID)
+
+	req, err := service.c.newRequest(ctx, http.MethodPut, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return nil, err
+	}
+
+	var secret AppSigningSecret
+	if err := service.c.do(req, &secret); err != nil {
+		return nil, err
+	}
+
+	return &secret, nil
+}
+
+// Delete removes the signing secret, requests to the app backend are no longer signed
+func (service *AppSigningSecretsService) Delete(ctx context.Context, organizationID, appDefinitionID string) error {
+	path := fmt.Sprintf("/organizations/%s/app_definitions/%s/signing_secret", organizationID, appDefinitionID)
+
+	req, err := service.c.newRequest(ctx, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, nil)
+}
+
+// GenerateAppSigningSecret returns a random secret of 64 characters
+func GenerateAppSigningSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buf), nil
+}
+
+// SignAppRequest signs the request the way contentful signs requests to an app
+// backend. The timestamp header is always signed in addition to signedHeaders.
+func SignAppRequest(secret string, req *http.Request, body []byte, signedHeaders ...string) {
+	req.Header.Set(AppTimestampHeader, strconv.FormatInt(time.Now().UnixMilli(), 10))
+
+	names := []string{AppTimestampHeader}
+	for _, name := range signedHeaders {
+		name = strings.ToLower(name)
+		if name != AppTimestampHeader {
+			names = append(names, name)
+		}
+	}
+
+	req.Header.Set(AppSignedHeadersHeader, strings.Join(names, ","))
+	req.Header.Set(AppSignatureHeader, appRequestSignature(secret, req, body, names))
+}
+
+// VerifyAppRequest checks the signature of a request sent by contentful to an
+// app backend. Requests older than ttl are rejected, a ttl of zero uses
+// DefaultAppRequestTTL. The timestamp has to be signed and may only be slightly
+// in the future. The body has to be read by the caller.
+func VerifyAppRequest(secret string, req *http.Request, body []byte, ttl time.Duration) error {
+	if ttl <= 0 {
+		ttl = DefaultAppRequestTTL
+	}
+
+	signature := req.Header.Get(AppSignatureHeader)
+	if signature == "" {
+		return AppRequestVerificationError{Reason: "missing signature"}
+	}
+
+	timestamp, err := strconv.ParseInt(req.Header.Get(AppTimestampHeader), 10, 64)
+	if err != nil {
+		return AppRequestVerificationError{Reason: "invalid timestamp"}
+	}
+
+	age := time.Since(time.UnixMilli(timestamp))
+	if age > ttl {
+		return AppRequestVerificationError{Reason: "request expired"}
+	}
+
+	if age < -appRequestClockSkew {
+		return AppRequestVerificationError{Reason: "timestamp in the future"}
+	}
+
+	var names []string
+	timestampSigned := false
+	for _, name := range strings.Split(req.Header.Get(AppSignedHeadersHeader), ",") {
+		if name = strings.TrimSpace(strings.ToLower(name)); name != "" {
+			names = append(names, name)
+			timestampSigned = timestampSigned || name == AppTimestampHeader
+		}
+	}
+
+	if !timestampSigned {
+		return AppRequestVerificationError{Reason: "timestamp not signed"}
+	}
+
+	expected := appRequestSignature(secret, req, body, names)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return AppRequestVerificationError{Reason: "signature mismatch"}
+	}
+
+	return nil
+}
+
+// appRequestSignature returns the hex encoded HMAC-SHA256 of the canonical request
+func appRequestSignature(secret string, req *http.Request, body []byte, signedHeaders []string) string {
+	names := append([]string(nil), signedHeaders...)
+	sort.Strings(names)
+
+	headers := make([]string, 0, len(names))
+	for _, name := range names {
+		headers = append(headers, name+":"+strings.TrimSpace(req.Header.Get(name)))
+	}
+
+	canonical := strings.Join([]string{
+		req.Method,
+		req.URL.RequestURI(),
+		strings.Join(headers, ";"),
+		string(body),
+	}, "\n")
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(canonical))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,162 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppSigningSecretsService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/organizations/organization_id/app_definitions/app_definition_id/signing_secret")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, `{"sys":{"type":"AppSigningSecret"},"redactedValue":"wI74"}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	secret, err := cma.AppSigningSecrets.Get(context.Background(), "organization_id", "app_definition_id")
+	assertions.Nil(err)
+	assertions.Equal("wI74", secret.RedactedValue)
+	assertions.Equal("", secret.Value)
+}
+
+func TestAppSigningSecretsService_Upsert(t *testing.T) {
+	assertions := assert.New(t)
+
+	value, err := GenerateAppSigningSecret()
+	assertions.Nil(err)
+	assertions.Equal(64, len(value))
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "PUT")
+		assertions.Equal(r.URL.Path, "/organizations/organization_id/app_definitions/app_definition_id/signing_secret")
+		checkHeaders(r, assertions)
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal(map[string]any{"value": value}, payload)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintf(w, `{"sys":{"type":"AppSigningSecret"},"redactedValue":"%s"}`, value[60:])
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	secret, err := cma.AppSigningSecrets.Upsert(context.Background(), "organization_id", "app_definition_id", value)
+	assertions.Nil(err)
+	assertions.Equal(value[60:], secret.RedactedValue)
+}
+
+func TestAppSigningSecretsService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "DELETE")
+		assertions.Equal(r.URL.Path, "/organizations/organization_id/app_definitions/app_definition_id/signing_secret")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(204)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	err := cma.AppSigningSecrets.Delete(context.Background(), "organization_id", "app_definition_id")
+	assertions.Nil(err)
+}
+
+func TestVerifyAppRequest(t *testing.T) {
+	assertions := assert.New(t)
+
+	secret := "my-secret"
+	body := []byte(`{"entityId":"entry_id"}`)
+
+	newRequest := func() *http.Request {
+		req, err := http.NewRequest(http.MethodPost, "https://example.com/hooks?x=1", nil)
+		assertions.Nil(err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Contentful-Topic", "Entry.publish")
+		SignAppRequest(secret, req, body, "Content-Type", "X-Contentful-Topic")
+
+		return req
+	}
+
+	req := newRequest()
+	assertions.Equal("x-contentful-timestamp,content-type,x-contentful-topic", req.Header.Get(AppSignedHeadersHeader))
+	assertions.Equal(64, len(req.Header.Get(AppSignatureHeader)))
+	assertions.Nil(VerifyAppRequest(secret, req, body, 0))
+
+	var verificationErr AppRequestVerificationError
+
+	err := VerifyAppRequest("other-secret", req, body, 0)
+	assertions.True(errors.As(err, &verificationErr))
+	assertions.Equal("signature mismatch", verificationErr.Reason)
+
+	err = VerifyAppRequest(secret, req, []byte(`{}`), 0)
+	assertions.True(errors.As(err, &verificationErr))
+
+	req = newRequest()
+	req.Header.Set("X-Contentful-Topic", "Entry.delete")
+	err = VerifyAppRequest(secret, req, body, 0)
+	assertions.True(errors.As(err, &verificationErr))
+	assertions.Equal("signature mismatch", verificationErr.Reason)
+
+	req = newRequest()
+	req.Header.Set(AppTimestampHeader, strconv.FormatInt(time.Now().Add(-time.Minute).UnixMilli(), 10))
+	err = VerifyAppRequest(secret, req, body, 0)
+	assertions.True(errors.As(err, &verificationErr))
+	assertions.Equal("request expired", verificationErr.Reason)
+
+	req = newRequest()
+	req.Header.Set(AppTimestampHeader, strconv.FormatInt(time.Now().Add(time.Minute).UnixMilli(), 10))
+	err = VerifyAppRequest(secret, req, body, 0)
+	assertions.True(errors.As(err, &verificationErr))
+	assertions.Equal("timestamp in the future", verificationErr.Reason)
+
+	// a correct signature which does not cover the timestamp
+	req = newRequest()
+	signed := []string{"content-type", "x-contentful-topic"}
+	req.Header.Set(AppSignedHeadersHeader, "content-type,x-contentful-topic")
+	req.Header.Set(AppSignatureHeader, appRequestSignature(secret, req, body, signed))
+	err = VerifyAppRequest(secret, req, body, 0)
+	assertions.True(errors.As(err, &verificationErr))
+	assertions.Equal("timestamp not signed", verificationErr.Reason)
+
+	req = newRequest()
+	req.Header.Del(AppSignatureHeader)
+	err = VerifyAppRequest(secret, req, body, 0)
+	assertions.True(errors.As(err, &verificationErr))
+	assertions.Equal("missing signature", verificationErr.Reason)
+}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strconv"
 )
 
@@ -13,9 +15,10 @@ type AssetsService service
 
 // Asset represents a Contentful asset
 type Asset struct {
-	Locale string
-	Sys    *Sys         `json:"sys,omitempty"`
-	Fields *AssetFields `json:"fields,omitempty"`
+	Locale   string
+	Sys      *Sys         `json:"sys,omitempty"`
+	Metadata *Metadata    `json:"metadata,omitempty"`
+	Fields   *AssetFields `json:"fields,omitempty"`
 }
 
 // AssetFields model
@@ -63,8 +66,8 @@ func (asset *Asset) GetVersion() int {
 }
 
 // List returns asset collection
-func (service *AssetsService) List(ctx context.Context, spaceID string, query *Query) (*Collection[Asset], error) {
-	path := fmt.Sprintf("/spaces/%s/assets", spaceID)
+func (service *AssetsService) List(ctx context.Context, env *Environment, query *Query) (*Collection[Asset], error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets", env.Sys.Space.Sys.ID, env.Sys.ID)
 	method := "GET"
 
 	req, err := service.c.newRequest(ctx, method, path, nil, nil)
@@ -80,9 +83,21 @@ func (service *AssetsService) List(ctx context.Context, spaceID string, query *Q
 	return col, nil
 }
 
+// Iterate returns an iterator decoding the assets of all pages one by one
+func (service *AssetsService) Iterate(ctx context.Context, env *Environment, query *Query) (*Iterator[Asset], error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets", env.Sys.Space.Sys.ID, env.Sys.ID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, url.Values{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return newIterator[Asset](query, service.c, req), nil
+}
+
 // ListPublished return a content type collection, with only activated content types
-func (service *AssetsService) ListPublished(ctx context.Context, spaceID string, query *Query) (*Collection[Asset], error) {
-	path := fmt.Sprintf("/spaces/%s/public/assets", spaceID)
+func (service *AssetsService) ListPublished(ctx context.Context, env *Environment, query *Query) (*Collection[Asset], error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/public/assets", env.Sys.Space.Sys.ID, env.Sys.ID)
 	method := "GET"
 
 	req, err := service.c.newRequest(ctx, method, path, nil, nil)
@@ -99,8 +114,8 @@ func (service *AssetsService) ListPublished(ctx context.Context, spaceID string,
 }
 
 // Get returns a single asset entity
-func (service *AssetsService) Get(ctx context.Context, spaceID, assetID string) (*Asset, error) {
-	path := fmt.Sprintf("/spaces/%s/assets/%s", spaceID, assetID)
+func (service *AssetsService) Get(ctx context.Context, env *Environment, assetID string) (*Asset, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s", env.Sys.Space.Sys.ID, env.Sys.ID, assetID)
 	method := "GET"
 
 	req, err := service.c.newRequest(ctx, method, path, nil, nil)
@@ -116,8 +131,19 @@ func (service *AssetsService) Get(ctx context.Context, spaceID, assetID string)
 	return &asset, nil
 }
 
+// GetMany returns the assets with the given ids in the order of ids, fetched
+// with as few sys.id[in] requests as possible. Missing ids are reported with a
+// MissingIDsError which is returned together with the found assets.
+func (service *AssetsService) GetMany(ctx context.Context, env *Environment, ids []string) ([]Asset, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets", env.Sys.Space.Sys.ID, env.Sys.ID)
+
+	return getMany(ctx, service.c, path, ids, func(asset *Asset) string {
+		return asset.Sys.ID
+	})
+}
+
 // Upsert updates or creates a new asset entity
-func (service *AssetsService) Upsert(ctx context.Context, spaceID string, asset *Asset) error {
+func (service *AssetsService) Upsert(ctx context.Context, env *Environment, asset *Asset) error {
 	bytesArray, err := json.Marshal(asset)
 	if err != nil {
 		return err
@@ -127,10 +153,10 @@ func (service *AssetsService) Upsert(ctx context.Context, spaceID string, asset
 	var method string
 
 	if asset.Sys != nil && asset.Sys.ID != "" {
-		path = fmt.Sprintf("/spaces/%s/assets/%s", spaceID, asset.Sys.ID)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/assets/%s", env.Sys.Space.Sys.ID, env.Sys.ID, asset.Sys.ID)
 		method = "PUT"
 	} else {
-		path = fmt.Sprintf("/spaces/%s/assets", spaceID)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/assets", env.Sys.Space.Sys.ID, env.Sys.ID)
 		method = "POST"
 	}
 
@@ -145,8 +171,8 @@ func (service *AssetsService) Upsert(ctx context.Context, spaceID string, asset
 }
 
 // Delete sends delete request
-func (service *AssetsService) Delete(ctx context.Context, spaceID string, asset *Asset) error {
-	path := fmt.Sprintf("/spaces/%s/assets/%s", spaceID, asset.Sys.ID)
+func (service *AssetsService) Delete(ctx context.Context, env *Environment, asset *Asset) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s", env.Sys.Space.Sys.ID, env.Sys.ID, asset.Sys.ID)
 	method := "DELETE"
 
 	req, err := service.c.newRequest(ctx, method, path, nil, nil)
@@ -161,8 +187,8 @@ func (service *AssetsService) Delete(ctx context.Context, spaceID string, asset
 }
 
 // Process the asset
-func (service *AssetsService) Process(ctx context.Context, spaceID string, asset *Asset) error {
-	path := fmt.Sprintf("/spaces/%s/assets/%s/files/%s/process", spaceID, asset.Sys.ID, asset.Locale)
+func (service *AssetsService) Process(ctx context.Context, env *Environment, asset *Asset) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/files/%s/process", env.Sys.Space.Sys.ID, env.Sys.ID, asset.Sys.ID, asset.Locale)
 	method := "PUT"
 
 	req, err := service.c.newRequest(ctx, method, path, nil, nil)
@@ -177,8 +203,8 @@ func (service *AssetsService) Process(ctx context.Context, spaceID string, asset
 }
 
 // Publish published the asset
-func (service *AssetsService) Publish(ctx context.Context, spaceID string, asset *Asset) error {
-	path := fmt.Sprintf("/spaces/%s/assets/%s/published", spaceID, asset.Sys.ID)
+func (service *AssetsService) Publish(ctx context.Context, env *Environment, asset *Asset) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/published", env.Sys.Space.Sys.ID, env.Sys.ID, asset.Sys.ID)
 	method := "PUT"
 
 	req, err := service.c.newRequest(ctx, method, path, nil, nil)
@@ -193,8 +219,8 @@ func (service *AssetsService) Publish(ctx context.Context, spaceID string, asset
 }
 
 // Unpublish the asset
-func (service *AssetsService) Unpublish(ctx context.Context, spaceID string, asset *Asset) error {
-	path := fmt.Sprintf("/spaces/%s/assets/%s/published", spaceID, asset.Sys.ID)
+func (service *AssetsService) Unpublish(ctx context.Context, env *Environment, asset *Asset) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/published", env.Sys.Space.Sys.ID, env.Sys.ID, asset.Sys.ID)
 	method := "DELETE"
 
 	req, err := service.c.newRequest(ctx, method, path, nil, nil)
@@ -209,8 +235,8 @@ func (service *AssetsService) Unpublish(ctx context.Context, spaceID string, ass
 }
 
 // Archive archives the asset
-func (service *AssetsService) Archive(ctx context.Context, spaceID string, asset *Asset) error {
-	path := fmt.Sprintf("/spaces/%s/assets/%s/archived", spaceID, asset.Sys.ID)
+func (service *AssetsService) Archive(ctx context.Context, env *Environment, asset *Asset) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/archived", env.Sys.Space.Sys.ID, env.Sys.ID, asset.Sys.ID)
 	method := "PUT"
 
 	req, err := service.c.newRequest(ctx, method, path, nil, nil)
@@ -225,8 +251,8 @@ func (service *AssetsService) Archive(ctx context.Context, spaceID string, asset
 }
 
 // Unarchive unarchives the asset
-func (service *AssetsService) Unarchive(ctx context.Context, spaceID string, asset *Asset) error {
-	path := fmt.Sprintf("/spaces/%s/assets/%s/archived", spaceID, asset.Sys.ID)
+func (service *AssetsService) Unarchive(ctx context.Context, env *Environment, asset *Asset) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/archived", env.Sys.Space.Sys.ID, env.Sys.ID, asset.Sys.ID)
 	method := "DELETE"
 
 	req, err := service.c.newRequest(ctx, method, path, nil, nil)
@@ -18,7 +18,7 @@ func TestAssetsService_List(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/assets")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/assets")
 
 		checkHeaders(r, assertions)
 
@@ -34,7 +34,7 @@ func TestAssetsService_List(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	collection, err := cma.Assets.List(context.Background(), spaceID, nil)
+	collection, err := cma.Assets.List(context.Background(), env, nil)
 	require.NoError(t, err)
 	asset := collection.Items
 	assertions.Equal(3, len(asset))
@@ -47,7 +47,7 @@ func TestAssetsService_ListPublished(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/public/assets")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/public/assets")
 
 		checkHeaders(r, assertions)
 
@@ -63,7 +63,7 @@ func TestAssetsService_ListPublished(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	collection, err := cma.Assets.ListPublished(context.Background(), spaceID, nil)
+	collection, err := cma.Assets.ListPublished(context.Background(), env, nil)
 	require.NoError(t, err)
 	asset := collection.Items
 	assertions.Equal(3, len(asset))
@@ -76,7 +76,7 @@ func TestAssetsService_Get(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/assets/1x0xpXu4pSGS4OukSyWGUK")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/assets/1x0xpXu4pSGS4OukSyWGUK")
 
 		checkHeaders(r, assertions)
 
@@ -92,7 +92,7 @@ func TestAssetsService_Get(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	asset, err := cma.Assets.Get(context.Background(), spaceID, "1x0xpXu4pSGS4OukSyWGUK")
+	asset, err := cma.Assets.Get(context.Background(), env, "1x0xpXu4pSGS4OukSyWGUK")
 	assertions.Nil(err)
 	assertions.Equal("hehehe", asset.Fields.Title.Map["en-US"])
 }
@@ -103,7 +103,7 @@ func TestAssetsService_Get_2(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/assets/1x0xpXu4pSGS4OukSyWGUK")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/assets/1x0xpXu4pSGS4OukSyWGUK")
 
 		checkHeaders(r, assertions)
 
@@ -119,7 +119,7 @@ func TestAssetsService_Get_2(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	_, err = cma.Assets.Get(context.Background(), spaceID, "1x0xpXu4pSGS4OukSyWGUK")
+	_, err = cma.Assets.Get(context.Background(), env, "1x0xpXu4pSGS4OukSyWGUK")
 	assertions.NotNil(err)
 }
 
@@ -128,7 +128,7 @@ func TestAssetsService_Upsert_Create(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "POST")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/assets")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/assets")
 		checkHeaders(r, assertions)
 
 		var payload map[string]interface{}
@@ -185,7 +185,7 @@ func TestAssetsService_Upsert_Create(t *testing.T) {
 		},
 	}
 
-	err := cma.Assets.Upsert(context.Background(), spaceID, asset)
+	err := cma.Assets.Upsert(context.Background(), env, asset)
 	assertions.Nil(err)
 	assertions.Equal("hehehe", asset.Fields.Title.Map["en-US"])
 	assertions.Equal("d3b8dad44e5066cfb805e2357469ee64.png", asset.Fields.File.Map["en-US"].FileName)
@@ -197,7 +197,7 @@ func TestAssetsService_Upsert_Update(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/assets/3HNzx9gvJScKku4UmcekYw")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/assets/3HNzx9gvJScKku4UmcekYw")
 		checkHeaders(r, assertions)
 
 		var payload map[string]interface{}
@@ -227,7 +227,7 @@ func TestAssetsService_Upsert_Update(t *testing.T) {
 	asset.Fields.Title.Map["en-US"] = "updated"
 	asset.Fields.Description.Map["en-US"] = "also updated"
 
-	err = cma.Assets.Upsert(context.Background(), spaceID, asset)
+	err = cma.Assets.Upsert(context.Background(), env, asset)
 	assertions.Nil(err)
 	assertions.Equal("updated", asset.Fields.Title.Map["en-US"])
 	assertions.Equal("also updated", asset.Fields.Description.Map["en-US"])
@@ -239,7 +239,7 @@ func TestAssetsService_Delete(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "DELETE")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/assets/3HNzx9gvJScKku4UmcekYw")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/assets/3HNzx9gvJScKku4UmcekYw")
 		checkHeaders(r, assertions)
 
 		w.WriteHeader(200)
@@ -258,7 +258,7 @@ func TestAssetsService_Delete(t *testing.T) {
 	assertions.Nil(err)
 
 	// delete locale
-	err = cma.Assets.Delete(context.Background(), spaceID, asset)
+	err = cma.Assets.Delete(context.Background(), env, asset)
 	assertions.Nil(err)
 }
 
@@ -268,7 +268,7 @@ func TestAssetsService_Process(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/assets/3HNzx9gvJScKku4UmcekYw/files//process")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/assets/3HNzx9gvJScKku4UmcekYw/files//process")
 
 		checkHeaders(r, assertions)
 
@@ -288,7 +288,7 @@ func TestAssetsService_Process(t *testing.T) {
 	asset, err := assetFromTestData("asset_1.json")
 	assertions.Nil(err)
 
-	err = cma.Assets.Process(context.Background(), spaceID, asset)
+	err = cma.Assets.Process(context.Background(), env, asset)
 	assertions.Nil(err)
 }
 
@@ -298,7 +298,7 @@ func TestAssetsService_Publish(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/assets/3HNzx9gvJScKku4UmcekYw/published")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/assets/3HNzx9gvJScKku4UmcekYw/published")
 
 		checkHeaders(r, assertions)
 
@@ -318,7 +318,7 @@ func TestAssetsService_Publish(t *testing.T) {
 	asset, err := assetFromTestData("asset_1.json")
 	assertions.Nil(err)
 
-	err = cma.Assets.Publish(context.Background(), spaceID, asset)
+	err = cma.Assets.Publish(context.Background(), env, asset)
 	assertions.Nil(err)
 }
 
@@ -328,7 +328,7 @@ func TestContentTypesService_Unpublish(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "DELETE")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/assets/3HNzx9gvJScKku4UmcekYw/published")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/assets/3HNzx9gvJScKku4UmcekYw/published")
 
 		checkHeaders(r, assertions)
 
@@ -348,7 +348,7 @@ func TestContentTypesService_Unpublish(t *testing.T) {
 	asset, err := assetFromTestData("asset_1.json")
 	assertions.Nil(err)
 
-	err = cma.Assets.Unpublish(context.Background(), spaceID, asset)
+	err = cma.Assets.Unpublish(context.Background(), env, asset)
 	assertions.Nil(err)
 }
 
@@ -358,7 +358,7 @@ func TestAssetsService_Archive(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/assets/3HNzx9gvJScKku4UmcekYw/archived")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/assets/3HNzx9gvJScKku4UmcekYw/archived")
 
 		checkHeaders(r, assertions)
 
@@ -378,7 +378,7 @@ func TestAssetsService_Archive(t *testing.T) {
 	asset, err := assetFromTestData("asset_1.json")
 	assertions.Nil(err)
 
-	err = cma.Assets.Archive(context.Background(), spaceID, asset)
+	err = cma.Assets.Archive(context.Background(), env, asset)
 	assertions.Nil(err)
 }
 
@@ -388,7 +388,7 @@ func TestContentTypesService_Unarchive(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "DELETE")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/assets/3HNzx9gvJScKku4UmcekYw/archived")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/assets/3HNzx9gvJScKku4UmcekYw/archived")
 
 		checkHeaders(r, assertions)
 
@@ -408,6 +408,6 @@ func TestContentTypesService_Unarchive(t *testing.T) {
 	asset, err := assetFromTestData("asset_1.json")
 	assertions.Nil(err)
 
-	err = cma.Assets.Unarchive(context.Background(), spaceID, asset)
+	err = cma.Assets.Unarchive(context.Background(), env, asset)
 	assertions.Nil(err)
 }
@@ -0,0 +1,60 @@
+package contentful
+
+import (
+	"context"
+	"time"
+)
+
+// Backoff configures the polling intervals used while waiting for asynchronous operations
+type Backoff struct {
+	// Initial interval between two polls
+	Initial time.Duration
+
+	// Max interval between two polls
+	Max time.Duration
+
+	// Multiplier applied to the interval after each poll
+	Multiplier float64
+}
+
+// DefaultBackoff is used when no backoff is given
+var DefaultBackoff = Backoff{
+	Initial:    time.Second,
+	Max:        30 * time.Second,
+	Multiplier: 2,
+}
+
+// poll calls fn until it reports done, returns an error or the context is done
+func (b Backoff) poll(ctx context.Context, fn func() (bool, error)) error {
+	interval := b.Initial
+	if interval <= 0 {
+		interval = DefaultBackoff.Initial
+	}
+
+	for {
+		done, err := fn()
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		if b.Multiplier > 1 {
+			interval = time.Duration(float64(interval) * b.Multiplier)
+		}
+
+		if b.Max > 0 && interval > b.Max {
+			interval = b.Max
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff_Poll(t *testing.T) {
+	backoff := Backoff{Initial: time.Millisecond, Max: 2 * time.Millisecond, Multiplier: 3}
+
+	calls := 0
+	err := backoff.poll(context.Background(), func() (bool, error) {
+		calls++
+		return calls == 4, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 4, calls)
+
+	pollErr := errors.New("poll failed")
+	err = backoff.poll(context.Background(), func() (bool, error) {
+		return false, pollErr
+	})
+	assert.Equal(t, pollErr, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = backoff.poll(ctx, func() (bool, error) {
+		return false, nil
+	})
+	assert.Equal(t, context.Canceled, err)
+}
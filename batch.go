@@ -0,0 +1,103 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// maxBatchURLLength keeps batched requests below the url length the API accepts
+	maxBatchURLLength = 7000
+
+	// maxBatchSize is the largest page size of the API
+	maxBatchSize = 1000
+)
+
+// chunkIDs splits ids into chunks whose sys.id[in] values fit into the given
+// number of url characters, duplicates are requested once
+func chunkIDs(ids []string, available int) [][]string {
+	chunks := [][]string{}
+	chunk := []string{}
+	length := 0
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		// an escaped comma separates the ids
+		size := len(url.QueryEscape(id)) + 3
+		if len(chunk) > 0 && (length+size > available || len(chunk) == maxBatchSize) {
+			chunks = append(chunks, chunk)
+			chunk = []string{}
+			length = 0
+		}
+
+		chunk = append(chunk, id)
+		length += size
+	}
+
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// getMany fetches the items of a collection path by id in as few requests as
+// the url length allows. Items are returned in the order of ids, ids without
+// an item are reported with a MissingIDsError next to the found items.
+func getMany[T any](ctx context.Context, c *Client, path string, ids []string, itemID func(item *T) string) ([]T, error) {
+	// base url with an empty sys.id[in] value and the longest limit
+	base := len(c.BaseURL) + len(path) + len("?limit=1000&sys.id%5Bin%5D=")
+	for key, value := range c.QueryParams {
+		base += len(url.QueryEscape(key)) + len(url.QueryEscape(value)) + 2
+	}
+
+	available := maxBatchURLLength - base
+	if available <= 0 {
+		return nil, fmt.Errorf("base url of %s is too long for batched requests", path)
+	}
+
+	found := map[string]T{}
+	for _, chunk := range chunkIDs(ids, available) {
+		req, err := c.newRequest(ctx, http.MethodGet, path, url.Values{}, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		col, err := newCollection[T](NewQuery().IDs(chunk...).Limit(uint16(len(chunk))), c, req)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range col.Items {
+			found[itemID(&col.Items[i])] = col.Items[i]
+		}
+	}
+
+	items := make([]T, 0, len(ids))
+	missing := []string{}
+	reported := map[string]bool{}
+	for _, id := range ids {
+		item, ok := found[id]
+		if !ok {
+			if !reported[id] {
+				reported[id] = true
+				missing = append(missing, id)
+			}
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	if len(missing) > 0 {
+		return items, MissingIDsError{IDs: missing}
+	}
+
+	return items, nil
+}
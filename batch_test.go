@@ -0,0 +1,282 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// batchServer answers sys.id[in] queries with the known ids in reverse order
+func batchServer(assertions *assert.Assertions, itemType string, known map[string]bool, urls *[]string) *httptest.Server {
+	var mu sync.Mutex
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		checkHeaders(r, assertions)
+
+		mu.Lock()
+		*urls = append(*urls, r.URL.String())
+		mu.Unlock()
+
+		ids := strings.Split(r.URL.Query().Get("sys.id[in]"), ",")
+		assertions.Equal(fmt.Sprint(len(ids)), r.URL.Query().Get("limit"))
+
+		items := []map[string]any{}
+		for i := len(ids) - 1; i >= 0; i-- {
+			if known[ids[i]] {
+				items = append(items, map[string]any{"sys": map[string]any{"id": ids[i], "type": itemType}})
+			}
+		}
+
+		w.WriteHeader(200)
+		_ = json.NewEncoder(w).Encode(map[string]any{"total": len(items), "limit": len(ids), "items": items})
+	}))
+}
+
+func TestEntriesService_GetMany(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	urls := []string{}
+	server := batchServer(assertions, "Entry", map[string]bool{"a": true, "b": true, "c": true}, &urls)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	entries, err := cma.Entries.GetMany(context.Background(), env, []string{"c", "a", "b", "a"})
+	assertions.Nil(err)
+	assertions.Equal([]string{"c", "a", "b", "a"}, entryIDs(entries))
+	assertions.Equal(1, len(urls))
+	assertions.Equal("/spaces/"+spaceID+"/environments/"+environmentID+"/entries", strings.Split(urls[0], "?")[0])
+
+	entries, err = cma.Entries.GetMany(context.Background(), env, []string{"x", "b", "y", "x"})
+	var missing MissingIDsError
+	assertions.True(errors.As(err, &missing))
+	assertions.Equal([]string{"x", "y"}, missing.IDs)
+	assertions.Equal([]string{"b"}, entryIDs(entries))
+}
+
+func TestEntriesService_GetManyChunks(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	ids := []string{}
+	known := map[string]bool{}
+	for i := 0; i < 800; i++ {
+		id := fmt.Sprintf("%022d", i)
+		ids = append(ids, id)
+		known[id] = true
+	}
+
+	urls := []string{}
+	server := batchServer(assertions, "Entry", known, &urls)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	entries, err := cma.Entries.GetMany(context.Background(), env, ids)
+	assertions.Nil(err)
+	assertions.Equal(ids, entryIDs(entries))
+	assertions.True(len(urls) > 1)
+	for _, u := range urls {
+		assertions.True(len(server.URL)+len(u) <= maxBatchURLLength)
+	}
+}
+
+func TestAssetsService_GetMany(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	urls := []string{}
+	server := batchServer(assertions, "Asset", map[string]bool{"a": true, "b": true}, &urls)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	assets, err := cma.Assets.GetMany(context.Background(), env, []string{"b", "a"})
+	assertions.Nil(err)
+	assertions.Equal("b", assets[0].Sys.ID)
+	assertions.Equal("a", assets[1].Sys.ID)
+	assertions.Equal("/spaces/"+spaceID+"/environments/"+environmentID+"/assets", strings.Split(urls[0], "?")[0])
+}
+
+func TestChunkIDs(t *testing.T) {
+	assertions := assert.New(t)
+
+	assertions.Equal([][]string{{"aa", "bb"}, {"cc"}}, chunkIDs([]string{"aa", "bb", "aa", "cc"}, 10))
+	assertions.Equal([][]string{{"toolong"}}, chunkIDs([]string{"toolong"}, 1))
+	assertions.Equal([][]string{}, chunkIDs([]string{}, 10))
+}
+
+func TestClient_Coalescing(t *testing.T) {
+	assertions := assert.New(t)
+
+	var mu sync.Mutex
+	requests := 0
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+
+		<-release
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entry_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+	cma.SetCoalescing(true)
+
+	var wg sync.WaitGroup
+	results := make([]*Entry, 5)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			entry, err := cma.Entries.Get(context.Background(), env, "5KsDBWseXY6QegucYAoacS")
+			assertions.Nil(err)
+			results[i] = entry
+		}(i)
+	}
+
+	// wait until all requests joined the first one
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		cma.flights.mu.Lock()
+		callers := 0
+		for _, call := range cma.flights.calls {
+			callers = call.callers
+		}
+		cma.flights.mu.Unlock()
+
+		if callers == len(results) {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	assertions.Equal(1, requests)
+	for _, entry := range results {
+		assertions.Equal("5KsDBWseXY6QegucYAoacS", entry.Sys.ID)
+	}
+	// every caller decodes its own copy
+	assertions.True(results[0] != results[1])
+
+	cma.SetCoalescing(false)
+	assertions.Nil(cma.flights)
+}
+
+func TestFlightGroup_Cancellation(t *testing.T) {
+	assertions := assert.New(t)
+
+	g := &flightGroup{calls: map[string]*flightCall{}}
+	release := make(chan struct{})
+	fetched := make(chan error, 1)
+	fetch := func(ctx context.Context) ([]byte, error) {
+		select {
+		case <-release:
+			fetched <- nil
+			return []byte("body"), nil
+		case <-ctx.Done():
+			fetched <- ctx.Err()
+			return nil, ctx.Err()
+		}
+	}
+
+	// the first caller gives up, the waiting caller still gets the response
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leader := make(chan error, 1)
+	go func() {
+		_, err := g.do(leaderCtx, "key", fetch)
+		leader <- err
+	}()
+
+	waiter := make(chan []byte, 1)
+	go func() {
+		for {
+			g.mu.Lock()
+			call, ok := g.calls["key"]
+			joined := ok && call.callers == 1
+			g.mu.Unlock()
+			if joined {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+
+		body, err := g.do(context.Background(), "key", func(context.Context) ([]byte, error) {
+			return nil, errors.New("second fetch")
+		})
+		assertions.Nil(err)
+		waiter <- body
+	}()
+
+	for {
+		g.mu.Lock()
+		call, ok := g.calls["key"]
+		joined := ok && call.callers == 2
+		g.mu.Unlock()
+		if joined {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	cancelLeader()
+	assertions.Equal(context.Canceled, <-leader)
+
+	close(release)
+	assertions.Nil(<-fetched)
+	assertions.Equal("body", string(<-waiter))
+
+	// the call is canceled once no caller waits anymore
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := g.do(ctx, "other", func(ctx context.Context) ([]byte, error) {
+			<-ctx.Done()
+			fetched <- ctx.Err()
+			return nil, ctx.Err()
+		})
+		done <- err
+	}()
+	for {
+		g.mu.Lock()
+		_, ok := g.calls["other"]
+		g.mu.Unlock()
+		if ok {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	assertions.Equal(context.Canceled, <-done)
+	assertions.Equal(context.Canceled, <-fetched)
+
+	g.mu.Lock()
+	assertions.Equal(0, len(g.calls))
+	g.mu.Unlock()
+}
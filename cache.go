@@ -0,0 +1,483 @@
+package contentful
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheEntry is a cached response body with the validators needed to revalidate it
+type CacheEntry struct {
+	Key      string    `json:"key"`
+	ETag     string    `json:"etag,omitempty"`
+	Body     []byte    `json:"body"`
+	StoredAt time.Time `json:"storedAt"`
+	Expires  time.Time `json:"expires"`
+}
+
+// fresh reports whether the entry can be served without asking the server
+func (e *CacheEntry) fresh(now time.Time) bool {
+	return now.Before(e.Expires)
+}
+
+// CacheStore stores cache entries by key. Implementations have to be safe
+// for concurrent use.
+type CacheStore interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry) error
+	Delete(key string) error
+	Keys() ([]string, error)
+}
+
+// Cache caches GET responses of a client. Responses are keyed by method, url
+// and the authorization scope of the request, revalidated with If-None-Match
+// and served without a request while the Cache-Control max-age holds.
+type Cache struct {
+	store CacheStore
+	now   func() time.Time
+}
+
+// NewCache returns a cache backed by the given store
+func NewCache(store CacheStore) *Cache {
+	return &Cache{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// SetCache enables caching of GET requests with the given cache, a nil cache disables it
+func (c *Client) SetCache(cache *Cache) *Client {
+	c.cache = cache
+	return c
+}
+
+// cacheKey returns the key of a request. The authorization header is hashed so
+// responses of different tokens never mix and tokens are not written to the store.
+func cacheKey(req *http.Request) string {
+	scope := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return fmt.Sprintf("%s %s %s", req.Method, req.URL.String(), hex.EncodeToString(scope[:8]))
+}
+
+// cacheKeyPath returns the url path of a cache key
+func cacheKeyPath(key string) string {
+	parts := strings.SplitN(key, " ", 3)
+	if len(parts) < 2 {
+		return ""
+	}
+
+	u, err := url.Parse(parts[1])
+	if err != nil {
+		return ""
+	}
+
+	return u.Path
+}
+
+// lookup returns the cached entry of the request and whether it is fresh. A
+// stale entry is only returned if it has an etag to revalidate it with.
+func (cache *Cache) lookup(req *http.Request) (*CacheEntry, bool) {
+	if req.Method != http.MethodGet {
+		return nil, false
+	}
+
+	entry, ok := cache.store.Get(cacheKey(req))
+	if !ok {
+		return nil, false
+	}
+
+	if entry.fresh(cache.now()) {
+		return entry, true
+	}
+
+	if entry.ETag == "" {
+		return nil, false
+	}
+
+	return entry, false
+}
+
+// save stores the response body of a GET request. Failing stores never fail
+// the request, the response is just not cached.
+func (cache *Cache) save(req *http.Request, res *http.Response, body []byte) {
+	if req.Method != http.MethodGet || res.StatusCode != http.StatusOK {
+		return
+	}
+
+	maxAge, noStore := parseCacheControl(res.Header.Get("Cache-Control"))
+	etag := res.Header.Get("ETag")
+	if noStore || (etag == "" && maxAge <= 0) {
+		_ = cache.store.Delete(cacheKey(req))
+		return
+	}
+
+	now := cache.now()
+	_ = cache.store.Set(cacheKey(req), &CacheEntry{
+		Key:      cacheKey(req),
+		ETag:     etag,
+		Body:     body,
+		StoredAt: now,
+		Expires:  now.Add(maxAge),
+	})
+}
+
+// revalidated refreshes a cached entry after the server answered 304 Not Modified
+func (cache *Cache) revalidated(req *http.Request, res *http.Response, entry *CacheEntry) {
+	maxAge, _ := parseCacheControl(res.Header.Get("Cache-Control"))
+
+	refreshed := *entry
+	if etag := res.Header.Get("ETag"); etag != "" {
+		refreshed.ETag = etag
+	}
+	refreshed.StoredAt = cache.now()
+	refreshed.Expires = refreshed.StoredAt.Add(maxAge)
+
+	_ = cache.store.Set(entry.Key, &refreshed)
+}
+
+// written drops the cached responses a successful write request may have changed
+func (cache *Cache) written(req *http.Request) {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return
+	}
+
+	_ = cache.Invalidate(environmentPath(req.URL.Path))
+}
+
+// environmentPath returns the environment part of a path or the space part for
+// paths outside of environments, a response of any entity in there may embed the
+// changed entity as an include
+func environmentPath(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) >= 4 && parts[0] == "spaces" && parts[2] == "environments" {
+		return "/" + strings.Join(parts[:4], "/")
+	}
+
+	if len(parts) >= 2 && parts[0] == "spaces" {
+		return "/" + strings.Join(parts[:2], "/")
+	}
+
+	return path
+}
+
+// parseCacheControl returns the max-age of a Cache-Control header and whether
+// the response must not be stored. no-cache is a max-age of zero.
+func parseCacheControl(header string) (time.Duration, bool) {
+	var maxAge time.Duration
+	noCache := false
+	for _, directive := range strings.Split(header, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch strings.ToLower(name) {
+		case "no-store":
+			return 0, true
+		case "no-cache":
+			noCache = true
+		case "max-age":
+			seconds, err := strconv.Atoi(strings.Trim(value, `"`))
+			if err == nil && seconds > 0 {
+				maxAge = time.Duration(seconds) * time.Second
+			}
+		}
+	}
+
+	if noCache {
+		return 0, false
+	}
+
+	return maxAge, false
+}
+
+// Invalidate removes all cached responses whose url path starts with the given path
+func (cache *Cache) Invalidate(path string) error {
+	keys, err := cache.store.Keys()
+	if err != nil {
+		return err
+	}
+
+	prefix := strings.TrimSuffix(path, "/")
+	for _, key := range keys {
+		p := cacheKeyPath(key)
+		if p != prefix && !strings.HasPrefix(p, prefix+"/") {
+			continue
+		}
+
+		if err := cache.store.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// InvalidateEnvironment removes all cached responses of an environment
+func (cache *Cache) InvalidateEnvironment(spaceID, environmentID string) error {
+	return cache.Invalidate(fmt.Sprintf("/spaces/%s/environments/%s", spaceID, environmentID))
+}
+
+// InvalidateSys removes the cached responses a change of the given entity may
+// have changed, meant to be called with the sys of a webhook payload. Entities
+// are embedded in collections and includes, so the whole environment is
+// dropped. Payloads without an environment belong to master.
+func (cache *Cache) InvalidateSys(sys *Sys) error {
+	if sys == nil || sys.Space == nil || sys.Space.Sys == nil {
+		return errors.New("sys has no space")
+	}
+
+	environmentID := "master"
+	if sys.Environment != nil && sys.Environment.Sys != nil {
+		environmentID = sys.Environment.Sys.ID
+	}
+
+	return cache.InvalidateEnvironment(sys.Space.Sys.ID, environmentID)
+}
+
+// Purge removes all cached responses
+func (cache *Cache) Purge() error {
+	keys, err := cache.store.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := cache.store.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MemoryCacheStore is a CacheStore keeping the least recently used entries in memory
+type MemoryCacheStore struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	items      map[string]*list.Element
+}
+
+type memoryCacheItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewMemoryCacheStore returns a memory store holding up to maxEntries entries,
+// zero or less means no limit
+func NewMemoryCacheStore(maxEntries int) *MemoryCacheStore {
+	return &MemoryCacheStore{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      map[string]*list.Element{},
+	}
+}
+
+// Get returns the entry of key and marks it as recently used
+func (s *MemoryCacheStore) Get(key string) (*CacheEntry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	element, ok := s.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	s.ll.MoveToFront(element)
+	return element.Value.(*memoryCacheItem).entry, true
+}
+
+// Set stores the entry of key and evicts the least recently used entry when full
+func (s *MemoryCacheStore) Set(key string, entry *CacheEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if element, ok := s.items[key]; ok {
+		element.Value.(*memoryCacheItem).entry = entry
+		s.ll.MoveToFront(element)
+		return nil
+	}
+
+	s.items[key] = s.ll.PushFront(&memoryCacheItem{key: key, entry: entry})
+	if s.maxEntries > 0 && s.ll.Len() > s.maxEntries {
+		oldest := s.ll.Back()
+		s.ll.Remove(oldest)
+		delete(s.items, oldest.Value.(*memoryCacheItem).key)
+	}
+
+	return nil
+}
+
+// Delete removes the entry of key
+func (s *MemoryCacheStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if element, ok := s.items[key]; ok {
+		s.ll.Remove(element)
+		delete(s.items, key)
+	}
+
+	return nil
+}
+
+// Keys returns the keys of all entries
+func (s *MemoryCacheStore) Keys() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.items))
+	for key := range s.items {
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// Len returns the number of entries
+func (s *MemoryCacheStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.ll.Len()
+}
+
+// FileCacheStore is a CacheStore keeping one json file per entry in a directory.
+// The keys are indexed in memory when the store is opened, other processes
+// writing to the same directory are not seen by Keys.
+type FileCacheStore struct {
+	mu  sync.Mutex
+	dir string
+
+	// keys maps the file paths to the keys of their entries
+	keys map[string]string
+}
+
+// NewFileCacheStore returns a file store writing to dir, the directory is created if needed
+func NewFileCacheStore(dir string) (*FileCacheStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &FileCacheStore{dir: dir, keys: map[string]string{}}
+	for _, path := range paths {
+		entry, err := s.read(path)
+		if err != nil {
+			continue
+		}
+
+		s.keys[path] = entry.Key
+	}
+
+	return s, nil
+}
+
+func (s *FileCacheStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (s *FileCacheStore) read(path string) (*CacheEntry, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var entry CacheEntry
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// Get returns the entry of key, unreadable files are reported as missing
+func (s *FileCacheStore) Get(key string) (*CacheEntry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, err := s.read(s.path(key))
+	if err != nil || entry.Key != key {
+		return nil, false
+	}
+
+	return entry, true
+}
+
+// Set writes the entry of key, the file is replaced atomically
+func (s *FileCacheStore) Set(key string, entry *CacheEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tmp, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	path := s.path(key)
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	s.keys[path] = key
+
+	return nil
+}
+
+// Delete removes the entry of key
+func (s *FileCacheStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := s.path(key)
+	err := os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	delete(s.keys, path)
+
+	return nil
+}
+
+// Keys returns the keys of the index
+func (s *FileCacheStore) Keys() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.keys))
+	for _, key := range s.keys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys, nil
+}
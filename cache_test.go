@@ -0,0 +1,382 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache_Revalidation(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	requests := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assertions.Equal("GET", r.Method)
+		checkHeaders(r, assertions)
+
+		if requests > 1 {
+			assertions.Equal(`"v1"`, r.Header.Get("If-None-Match"))
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", `"v1"`)
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entry_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+	cma.SetCache(NewCache(NewMemoryCacheStore(10)))
+
+	entry, err := cma.Entries.Get(context.Background(), env, "5KsDBWseXY6QegucYAoacS")
+	assertions.Nil(err)
+	assertions.Equal("5KsDBWseXY6QegucYAoacS", entry.Sys.ID)
+
+	entry, err = cma.Entries.Get(context.Background(), env, "5KsDBWseXY6QegucYAoacS")
+	assertions.Nil(err)
+	assertions.Equal("5KsDBWseXY6QegucYAoacS", entry.Sys.ID)
+	assertions.Equal(2, requests)
+}
+
+func TestCache_MaxAge(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	requests := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assertions.Equal("", r.Header.Get("If-None-Match"))
+
+		w.Header().Set("Cache-Control", "public, max-age=60")
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entry.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewCache(NewMemoryCacheStore(0))
+	cache.now = func() time.Time { return now }
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+	cma.SetCache(cache)
+
+	for i := 0; i < 3; i++ {
+		collection, err := cma.Entries.List(context.Background(), env, nil)
+		assertions.Nil(err)
+		assertions.Equal("5KsDBWseXY6QegucYAoacS", collection.Items[0].Sys.ID)
+	}
+	assertions.Equal(1, requests)
+
+	// a different query is a different response
+	_, err = cma.Entries.List(context.Background(), env, NewQuery().Limit(1))
+	assertions.Nil(err)
+	assertions.Equal(2, requests)
+
+	// without an etag an expired response is fetched again
+	now = now.Add(time.Minute)
+	_, err = cma.Entries.List(context.Background(), env, nil)
+	assertions.Nil(err)
+	assertions.Equal(3, requests)
+}
+
+func TestCache_RevalidationHeaders(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		skip := r.URL.Query().Get("skip")
+		if skip == "" {
+			skip = "0"
+		}
+		etag := `"page-` + skip + `"`
+
+		if r.URL.Query().Get("limit") == "" {
+			// a conditional request of the caller, there is nothing cached for it
+			assertions.Equal(`"caller"`, r.Header.Get("If-None-Match"))
+			w.Header().Set("Cache-Control", "max-age=60")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		// only the first page is cached, its etag never leaks to the next one
+		if skip != "0" {
+			assertions.Equal("", r.Header.Get("If-None-Match"))
+		} else if inm := r.Header.Get("If-None-Match"); inm != "" {
+			assertions.Equal(etag, inm)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		} else {
+			w.Header().Set("ETag", etag)
+		}
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintf(w, `{"total": 2, "skip": %s, "limit": 1, "items": [{"sys": {"id": "e%s"}}]}`, skip, skip)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	store := NewMemoryCacheStore(0)
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+	cma.SetCache(NewCache(store))
+
+	for i := 0; i < 2; i++ {
+		col, err := cma.Entries.List(context.Background(), env, NewQuery().Limit(1))
+		assertions.Nil(err)
+		entries, err := col.collectAll(context.Background())
+		assertions.Nil(err)
+		assertions.Equal(2, len(entries))
+	}
+	assertions.Equal(1, store.Len())
+
+	// 304 responses to conditional requests of the caller are not cached
+	req, err := cma.newRequest(context.Background(), "GET", "/spaces/"+spaceID+"/environments/"+environmentID+"/entries", nil, nil)
+	assertions.Nil(err)
+	req.Header.Set("If-None-Match", `"caller"`)
+	err = cma.do(req, nil)
+	assertions.Nil(err)
+	assertions.Equal(1, store.Len())
+}
+
+func TestCache_AuthScope(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	requests := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		w.Header().Set("Cache-Control", "max-age=60")
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entry_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cache := NewCache(NewMemoryCacheStore(10))
+
+	// cma clients
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+	cma.SetCache(cache)
+
+	other := NewCMA("other-token")
+	other.BaseURL = server.URL
+	other.SetCache(cache)
+
+	_, err = cma.Entries.Get(context.Background(), env, "5KsDBWseXY6QegucYAoacS")
+	assertions.Nil(err)
+	_, err = other.Entries.Get(context.Background(), env, "5KsDBWseXY6QegucYAoacS")
+	assertions.Nil(err)
+	_, err = cma.Entries.Get(context.Background(), env, "5KsDBWseXY6QegucYAoacS")
+	assertions.Nil(err)
+	assertions.Equal(2, requests)
+}
+
+func TestCache_Invalidation(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	requests := map[string]int{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method]++
+
+		w.Header().Set("Cache-Control", "max-age=3600")
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entry_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	store := NewMemoryCacheStore(0)
+	cache := NewCache(store)
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+	cma.SetCache(cache)
+
+	entry, err := cma.Entries.Get(context.Background(), env, "5KsDBWseXY6QegucYAoacS")
+	assertions.Nil(err)
+	assertions.Equal(1, store.Len())
+
+	// writes drop the responses of their environment
+	err = cma.Entries.Upsert(context.Background(), env, "blogPost", entry)
+	assertions.Nil(err)
+	assertions.Equal(0, store.Len())
+
+	_, err = cma.Entries.Get(context.Background(), env, "5KsDBWseXY6QegucYAoacS")
+	assertions.Nil(err)
+	assertions.Equal(2, requests["GET"])
+
+	// other environments are kept
+	err = cache.InvalidateEnvironment(spaceID, "staging")
+	assertions.Nil(err)
+	assertions.Equal(1, store.Len())
+
+	// webhook payload
+	err = cache.InvalidateSys(&Sys{
+		ID:          "5KsDBWseXY6QegucYAoacS",
+		Space:       &Space{Sys: &Sys{ID: spaceID}},
+		Environment: &Link{Sys: &Sys{ID: environmentID}},
+	})
+	assertions.Nil(err)
+	assertions.Equal(0, store.Len())
+
+	err = cache.InvalidateSys(&Sys{ID: "5KsDBWseXY6QegucYAoacS"})
+	assertions.NotNil(err)
+
+	_, err = cma.Entries.Get(context.Background(), env, "5KsDBWseXY6QegucYAoacS")
+	assertions.Nil(err)
+	err = cache.Invalidate("/spaces/" + spaceID + "/environments/" + environmentID + "/entries/5KsDBWseXY6QegucYAoacS")
+	assertions.Nil(err)
+	assertions.Equal(0, store.Len())
+}
+
+func TestCache_UploadsKeepResponses(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			w.WriteHeader(204)
+			return
+		}
+
+		w.Header().Set("Cache-Control", "max-age=3600")
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entry_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	store := NewMemoryCacheStore(0)
+	cache := NewCache(store)
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+	cma.SetCache(cache)
+
+	// upload client
+	urc := NewResourceClient(CMAToken)
+	urc.BaseURL = server.URL
+	urc.SetCache(cache)
+
+	_, err = cma.Entries.Get(context.Background(), env, "5KsDBWseXY6QegucYAoacS")
+	assertions.Nil(err)
+	assertions.Equal(1, store.Len())
+
+	err = urc.Resources.Delete(context.Background(), spaceID, "upload-id")
+	assertions.Nil(err)
+	assertions.Equal(1, store.Len())
+}
+
+func TestMemoryCacheStore_Eviction(t *testing.T) {
+	assertions := assert.New(t)
+
+	store := NewMemoryCacheStore(2)
+	assertions.Nil(store.Set("a", &CacheEntry{Key: "a"}))
+	assertions.Nil(store.Set("b", &CacheEntry{Key: "b"}))
+
+	_, ok := store.Get("a")
+	assertions.True(ok)
+
+	assertions.Nil(store.Set("c", &CacheEntry{Key: "c"}))
+	assertions.Equal(2, store.Len())
+
+	_, ok = store.Get("b")
+	assertions.False(ok)
+	_, ok = store.Get("a")
+	assertions.True(ok)
+	_, ok = store.Get("c")
+	assertions.True(ok)
+}
+
+func TestFileCacheStore(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	dir := t.TempDir()
+	store, err := NewFileCacheStore(dir)
+	assertions.Nil(err)
+
+	key := "GET https://cdn.contentful.com/spaces/id1/environments/env-id/entries scope"
+	err = store.Set(key, &CacheEntry{Key: key, ETag: `"v1"`, Body: []byte(`{"items":[]}`)})
+	assertions.Nil(err)
+
+	// entries survive a new store on the same directory
+	store, err = NewFileCacheStore(dir)
+	assertions.Nil(err)
+
+	entry, ok := store.Get(key)
+	assertions.True(ok)
+	assertions.Equal(`"v1"`, entry.ETag)
+	assertions.Equal(`{"items":[]}`, string(entry.Body))
+
+	keys, err := store.Keys()
+	assertions.Nil(err)
+	assertions.Equal([]string{key}, keys)
+
+	// the index follows writes and deletes
+	other := "GET https://cdn.contentful.com/spaces/id1/environments/staging/entries scope"
+	assertions.Nil(store.Set(other, &CacheEntry{Key: other}))
+	keys, err = store.Keys()
+	assertions.Nil(err)
+	assertions.Equal([]string{key, other}, keys)
+
+	err = NewCache(store).InvalidateEnvironment("id1", "env-id")
+	assertions.Nil(err)
+	_, ok = store.Get(key)
+	assertions.False(ok)
+
+	assertions.Nil(store.Delete(key))
+	keys, err = store.Keys()
+	assertions.Nil(err)
+	assertions.Equal([]string{other}, keys)
+}
+
+func TestParseCacheControl(t *testing.T) {
+	assertions := assert.New(t)
+
+	maxAge, noStore := parseCacheControl("public, max-age=300")
+	assertions.Equal(5*time.Minute, maxAge)
+	assertions.False(noStore)
+
+	maxAge, noStore = parseCacheControl("max-age=300, no-cache")
+	assertions.Equal(time.Duration(0), maxAge)
+	assertions.False(noStore)
+
+	_, noStore = parseCacheControl("no-store")
+	assertions.True(noStore)
+
+	maxAge, _ = parseCacheControl("")
+	assertions.Equal(time.Duration(0), maxAge)
+}
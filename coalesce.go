@@ -0,0 +1,105 @@
+package contentful
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// SetCoalescing enables or disables coalescing of identical GET requests.
+// While a GET is in flight, the same request with the same authorization
+// waits for its response instead of being sent again. The shared request runs
+// until every caller waiting for it gave up, a caller whose ctx is done
+// returns its own error without failing the others.
+func (c *Client) SetCoalescing(enabled bool) *Client {
+	if !enabled {
+		c.flights = nil
+		return c
+	}
+
+	if c.flights == nil {
+		c.flights = &flightGroup{calls: map[string]*flightCall{}}
+	}
+
+	return c
+}
+
+// flightGroup dedupes calls with the same key while one of them is running
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+type flightCall struct {
+	done chan struct{}
+	body []byte
+	err  error
+
+	// callers still waiting for the result, the call is canceled once all of them gave up
+	callers int
+	cancel  context.CancelFunc
+}
+
+// do runs fn for the first caller of a key, later callers wait for its result
+// until their own ctx is done. fn gets a context with the values of the first
+// caller's ctx, which is only canceled when no caller waits anymore.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	g.mu.Lock()
+	call, ok := g.calls[key]
+	if ok {
+		call.callers++
+	} else {
+		callCtx, cancel := context.WithCancel(detachedContext{parent: ctx})
+		call = &flightCall{done: make(chan struct{}), callers: 1, cancel: cancel}
+		g.calls[key] = call
+		go g.run(callCtx, key, call, fn)
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.body, call.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		call.callers--
+		if call.callers == 0 {
+			call.cancel()
+			g.forget(key, call)
+		}
+		g.mu.Unlock()
+
+		return nil, ctx.Err()
+	}
+}
+
+func (g *flightGroup) run(ctx context.Context, key string, call *flightCall, fn func(ctx context.Context) ([]byte, error)) {
+	call.body, call.err = fn(ctx)
+	call.cancel()
+
+	g.mu.Lock()
+	g.forget(key, call)
+	g.mu.Unlock()
+
+	close(call.done)
+}
+
+// forget removes the call, later callers of the key start a new one. g.mu has to be held.
+func (g *flightGroup) forget(key string, call *flightCall) {
+	if g.calls[key] == call {
+		delete(g.calls, key)
+	}
+}
+
+// detachedContext keeps the values of its parent but not its deadline and
+// cancellation, like context.WithoutCancel of newer go versions
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+
+func (detachedContext) Done() <-chan struct{} { return nil }
+
+func (detachedContext) Err() error { return nil }
+
+func (c detachedContext) Value(key any) any { return c.parent.Value(key) }
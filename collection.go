@@ -60,10 +60,17 @@ type Collection[T any] struct {
 
 // newCollection initializes a new collection
 // if query is nil, order sys.createdAt
+// the query is copied, paging does not modify the query of the caller
 func newCollection[T any](query *Query, client *Client, req *http.Request) (*Collection[T], error) {
 	if query == nil {
 		query = NewQuery()
 		query.Order("sys.createdAt", true)
+	} else {
+		query = query.Clone()
+	}
+
+	if err := query.Validate(); err != nil {
+		return nil, err
 	}
 	col := &Collection[T]{
 		query: query,
@@ -84,10 +91,14 @@ func (col *Collection[T]) Next(ctx context.Context) (*Collection[T], error) {
 	col.query.Skip(skip)
 
 	// override request query
-	col.req.URL.RawQuery = col.query.String()
+	values, err := col.query.BuildValues()
+	if err != nil {
+		return nil, err
+	}
+	col.req.URL.RawQuery = values.Encode()
 
 	// makes api call
-	err := col.c.do(col.req, col)
+	err = col.c.do(col.req, col)
 	if err != nil {
 		return nil, err
 	}
@@ -96,3 +107,23 @@ func (col *Collection[T]) Next(ctx context.Context) (*Collection[T], error) {
 
 	return col, nil
 }
+
+// collectAll fetches the remaining pages of the collection and returns all items
+func (col *Collection[T]) collectAll(ctx context.Context) ([]T, error) {
+	items := append([]T{}, col.Items...)
+
+	for len(items) < col.Total {
+		next, err := col.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(next.Items) == 0 {
+			break
+		}
+
+		items = append(items, next.Items...)
+	}
+
+	return items, nil
+}
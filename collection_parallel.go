@@ -0,0 +1,161 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrorPolicy decides what FetchAll does when a page fails
+type ErrorPolicy int
+
+const (
+	// FailFast cancels the remaining pages on the first error and returns it
+	FailFast ErrorPolicy = iota
+	// CollectErrors fetches every page and returns the items of the pages that
+	// succeeded together with a PageErrors for the failed ones
+	CollectErrors
+)
+
+// DefaultFetchWorkers is the number of concurrent page requests when none is given
+const DefaultFetchWorkers = 4
+
+// FetchOptions configures FetchAll
+type FetchOptions struct {
+	// Workers is the number of pages fetched at the same time
+	Workers int
+
+	// ErrorPolicy decides what happens when a page fails
+	ErrorPolicy ErrorPolicy
+}
+
+// PageError is the error of a single page
+type PageError struct {
+	Skip int
+	Err  error
+}
+
+// PageErrors is returned by FetchAll with the CollectErrors policy
+type PageErrors struct {
+	Errors []PageError
+}
+
+func (e PageErrors) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, pageErr := range e.Errors {
+		msgs = append(msgs, fmt.Sprintf("skip %d: %s", pageErr.Skip, pageErr.Err))
+	}
+
+	return fmt.Sprintf("%d pages failed: %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// FetchAll fetches the remaining pages of the collection concurrently and
+// returns all items in the order of the pages. The page size and the total
+// are taken from the first page. Workers share the client, so a rate limit
+// response pauses all of them until the reset the API sent. Once a response
+// used up the per second limit, the workers pause for a second.
+func (col *Collection[T]) FetchAll(ctx context.Context, options FetchOptions) ([]T, error) {
+	workers := options.Workers
+	if workers <= 0 {
+		workers = DefaultFetchWorkers
+	}
+
+	if col.Limit <= 0 || len(col.Items) >= col.Total {
+		return append([]T{}, col.Items...), nil
+	}
+
+	skips := []int{}
+	for skip := col.Skip + col.Limit; skip < col.Total; skip += col.Limit {
+		skips = append(skips, skip)
+	}
+
+	ctx, cancel := context.WithCancel(withPacing(ctx))
+	defer cancel()
+
+	pages := make([][]T, len(skips))
+	errs := make([]error, len(skips))
+	jobs := make(chan int)
+
+	var firstErr error
+	var once sync.Once
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers && i < len(skips); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				pages[index], errs[index] = col.fetchPage(ctx, skips[index])
+				if errs[index] != nil && options.ErrorPolicy == FailFast {
+					once.Do(func() {
+						firstErr = errs[index]
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+	for index := range skips {
+		if options.ErrorPolicy == FailFast && ctx.Err() != nil {
+			break
+		}
+		jobs <- index
+	}
+	close(jobs)
+	wg.Wait()
+
+	items := append([]T{}, col.Items...)
+	pageErrors := PageErrors{}
+	for index, err := range errs {
+		if err != nil {
+			pageErrors.Errors = append(pageErrors.Errors, PageError{Skip: skips[index], Err: err})
+			continue
+		}
+
+		items = append(items, pages[index]...)
+	}
+
+	if options.ErrorPolicy == FailFast {
+		if firstErr != nil {
+			return nil, firstErr
+		}
+
+		// the caller's context ended before all pages were requested
+		if len(items) < col.Total {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		return items, nil
+	}
+
+	if len(pageErrors.Errors) > 0 {
+		return items, pageErrors
+	}
+
+	return items, nil
+}
+
+// fetchPage fetches the items of the page starting at skip
+func (col *Collection[T]) fetchPage(ctx context.Context, skip int) ([]T, error) {
+	// skip is set on the values, the uint16 of Query.Skip can not hold the offsets of large collections
+	values, err := col.query.BuildValues()
+	if err != nil {
+		return nil, err
+	}
+	values.Set("skip", strconv.Itoa(skip))
+
+	req := col.req.Clone(ctx)
+	req.URL.RawQuery = values.Encode()
+
+	var page Collection[T]
+	if err := col.c.do(req, &page); err != nil {
+		return nil, err
+	}
+
+	return page.Items, nil
+}
@@ -0,0 +1,248 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pagedServer serves total entries in pages of the requested limit, fail decides the status of a page
+func pagedServer(assertions *assert.Assertions, total int, fail func(skip int) int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		checkHeaders(r, assertions)
+
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		if status := fail(skip); status != 200 {
+			if status == http.StatusTooManyRequests {
+				w.Header().Set("X-Contentful-RateLimit-Reset", "1")
+				w.WriteHeader(status)
+				_, _ = fmt.Fprintln(w, readTestData("error_ratelimit.json"))
+				return
+			}
+
+			w.WriteHeader(status)
+			_, _ = fmt.Fprintln(w, readTestData("error_notfound.json"))
+			return
+		}
+
+		// later pages answer first
+		time.Sleep(time.Duration(total-skip) * time.Microsecond)
+
+		items := []map[string]any{}
+		for i := skip; i < skip+limit && i < total; i++ {
+			items = append(items, map[string]any{"sys": map[string]any{"id": fmt.Sprintf("e%d", i), "type": "Entry"}})
+		}
+
+		w.WriteHeader(200)
+		_ = json.NewEncoder(w).Encode(map[string]any{"total": total, "skip": skip, "limit": limit, "items": items})
+	}))
+}
+
+func TestCollection_FetchAll(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	server := pagedServer(assertions, 1050, func(int) int { return 200 })
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	col, err := cma.Entries.List(context.Background(), env, NewQuery().Limit(100))
+	assertions.Nil(err)
+
+	entries, err := col.FetchAll(context.Background(), FetchOptions{Workers: 3})
+	assertions.Nil(err)
+	assertions.Equal(1050, len(entries))
+	for i, entry := range entries {
+		assertions.Equal(fmt.Sprintf("e%d", i), entry.Sys.ID)
+	}
+
+	// default workers
+	col, err = cma.Entries.List(context.Background(), env, NewQuery().Limit(1000))
+	assertions.Nil(err)
+	entries, err = col.FetchAll(context.Background(), FetchOptions{})
+	assertions.Nil(err)
+	assertions.Equal(1050, len(entries))
+	assertions.Equal("e1049", entries[1049].Sys.ID)
+}
+
+func TestCollection_FetchAllInvalidQuery(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	server := pagedServer(assertions, 250, func(int) int { return 200 })
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	col, err := cma.Entries.List(context.Background(), env, NewQuery().Limit(100))
+	assertions.Nil(err)
+
+	// a query broken after the first page fails the requests instead of panicking
+	col.query.Include(11)
+
+	_, err = col.FetchAll(context.Background(), FetchOptions{Workers: 2})
+	assertions.NotNil(err)
+
+	_, err = col.Next(context.Background())
+	assertions.NotNil(err)
+}
+
+func TestCollection_FetchAllLargeSkip(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	var mu sync.Mutex
+	skips := map[string]bool{}
+	server := pagedServer(assertions, 70500, func(skip int) int {
+		mu.Lock()
+		skips[strconv.Itoa(skip)] = true
+		mu.Unlock()
+		return 200
+	})
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	col, err := cma.Entries.List(context.Background(), env, NewQuery().Limit(1000))
+	assertions.Nil(err)
+
+	entries, err := col.FetchAll(context.Background(), FetchOptions{Workers: 8})
+	assertions.Nil(err)
+	assertions.Equal(70500, len(entries))
+	assertions.True(skips["70000"])
+	assertions.Equal("e70499", entries[70499].Sys.ID)
+}
+
+func TestCollection_FetchAllErrors(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	server := pagedServer(assertions, 500, func(skip int) int {
+		if skip == 200 || skip == 400 {
+			return 404
+		}
+		return 200
+	})
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	col, err := cma.Entries.List(context.Background(), env, NewQuery().Limit(100))
+	assertions.Nil(err)
+
+	entries, err := col.FetchAll(context.Background(), FetchOptions{Workers: 2})
+	assertions.Nil(entries)
+	var notFound NotFoundError
+	assertions.True(errors.As(err, &notFound))
+
+	entries, err = col.FetchAll(context.Background(), FetchOptions{Workers: 2, ErrorPolicy: CollectErrors})
+	var pageErrors PageErrors
+	assertions.True(errors.As(err, &pageErrors))
+	assertions.Equal(2, len(pageErrors.Errors))
+	assertions.Equal(200, pageErrors.Errors[0].Skip)
+	assertions.Equal(400, pageErrors.Errors[1].Skip)
+	assertions.Equal(300, len(entries))
+	assertions.Equal("e0", entries[0].Sys.ID)
+	assertions.Equal("e300", entries[200].Sys.ID)
+}
+
+func TestCollection_FetchAllRateLimit(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	var mu sync.Mutex
+	limited := false
+	var limitedAt time.Time
+	requestsDuringPause := 0
+	server := pagedServer(assertions, 400, func(skip int) int {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if skip == 100 && !limited {
+			limited = true
+			limitedAt = time.Now()
+			return http.StatusTooManyRequests
+		}
+
+		if limited && time.Since(limitedAt) < 900*time.Millisecond {
+			requestsDuringPause++
+		}
+		return 200
+	})
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	col, err := cma.Entries.List(context.Background(), env, NewQuery().Limit(100))
+	assertions.Nil(err)
+
+	entries, err := col.FetchAll(context.Background(), FetchOptions{Workers: 1})
+	assertions.Nil(err)
+	assertions.Equal(400, len(entries))
+	assertions.True(limited)
+	assertions.Equal(0, requestsDuringPause)
+}
+
+func TestCollection_FetchAllPacing(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	var mu sync.Mutex
+	var exhaustedAt time.Time
+	early := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+
+		mu.Lock()
+		if !exhaustedAt.IsZero() && time.Since(exhaustedAt) < 900*time.Millisecond {
+			early++
+		}
+		if skip == 100 {
+			exhaustedAt = time.Now()
+			w.Header().Set("X-Contentful-Ratelimit-Second-Remaining", "0")
+		}
+		mu.Unlock()
+
+		items := []map[string]any{}
+		for i := skip; i < skip+100 && i < 300; i++ {
+			items = append(items, map[string]any{"sys": map[string]any{"id": fmt.Sprintf("e%d", i), "type": "Entry"}})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"total": 300, "skip": skip, "limit": 100, "items": items})
+	}))
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	col, err := cma.Entries.List(context.Background(), env, NewQuery().Limit(100))
+	assertions.Nil(err)
+
+	entries, err := col.FetchAll(context.Background(), FetchOptions{Workers: 1})
+	assertions.Nil(err)
+	assertions.Equal(300, len(entries))
+	assertions.Equal(0, early)
+}
@@ -1,7 +1,11 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -72,3 +76,49 @@ func TestNewCollection(t *testing.T) {
 	setup()
 	defer teardown()
 }
+
+func TestNewCollection_QueryIsNotModified(t *testing.T) {
+	assertions := assert.New(t)
+
+	var skips []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		skips = append(skips, r.URL.Query().Get("skip"))
+		assertions.Equal("product", r.URL.Query().Get("content_type"))
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entry.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	query := NewQuery().ContentType("product")
+
+	col, err := cma.Entries.List(context.Background(), env, query)
+	assertions.Nil(err)
+
+	_, err = col.Next(context.Background())
+	assertions.Nil(err)
+
+	_, err = cma.Entries.List(context.Background(), env, query)
+	assertions.Nil(err)
+
+	assertions.Equal([]string{"", "100", ""}, skips)
+	assertions.Equal("content_type=product", query.String())
+}
+
+func TestNewCollection_InvalidQuery(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma = NewCMA(CMAToken)
+
+	assertions.NotPanics(func() {
+		_, err := cma.Entries.List(context.Background(), env, NewQuery().Limit(3000))
+		assertions.NotNil(err)
+	})
+}
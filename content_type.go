@@ -37,6 +37,9 @@ const (
 	// FieldTypeInteger content type field type for integer data
 	FieldTypeInteger = "Integer"
 
+	// FieldTypeNumber content type field type for decimal data
+	FieldTypeNumber = "Number"
+
 	// FieldTypeLocation content type field type for location data
 	FieldTypeLocation = "Location"
 
@@ -294,7 +297,7 @@ func (item *FieldTypeArrayItem) UnmarshalJSON(data []byte) error {
 		item.Validations = validations
 	}
 
-	if val, ok := payload["linktype"]; ok {
+	if val, ok := payload["linkType"]; ok {
 		item.LinkType = val.(string)
 	}
 
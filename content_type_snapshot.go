@@ -0,0 +1,217 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// ContentTypeFieldChange lists the changed attributes of a content type field
+type ContentTypeFieldChange struct {
+	Type    FieldChangeType
+	FieldID string
+
+	// Attributes holds the json names of the changed attributes, e.g. "required"
+	Attributes []string
+}
+
+func (change ContentTypeFieldChange) String() string {
+	if change.Type != FieldChangeChanged {
+		return fmt.Sprintf("%s %s", change.Type, change.FieldID)
+	}
+
+	return fmt.Sprintf("%s %s %v", change.Type, change.FieldID, change.Attributes)
+}
+
+// ContentTypeChange is an entry of the content type change log
+type ContentTypeChange struct {
+	// Snapshot introducing the changes
+	Snapshot *ContentTypeSnapshot
+
+	// Previous snapshot, nil for the first snapshot
+	Previous *ContentTypeSnapshot
+
+	Fields []ContentTypeFieldChange
+}
+
+// DiffContentTypeFields compares the fields of two content types
+func DiffContentTypeFields(from, to []*Field) ([]ContentTypeFieldChange, error) {
+	fromFields := map[string]*Field{}
+	for _, field := range from {
+		fromFields[field.ID] = field
+	}
+
+	var changes []ContentTypeFieldChange
+	seen := map[string]bool{}
+	for _, field := range to {
+		seen[field.ID] = true
+
+		previous, ok := fromFields[field.ID]
+		if !ok {
+			changes = append(changes, ContentTypeFieldChange{Type: FieldChangeAdded, FieldID: field.ID})
+			continue
+		}
+
+		attributes, err := changedFieldAttributes(previous, field)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(attributes) > 0 {
+			changes = append(changes, ContentTypeFieldChange{Type: FieldChangeChanged, FieldID: field.ID, Attributes: attributes})
+		}
+	}
+
+	for _, field := range from {
+		if !seen[field.ID] {
+			changes = append(changes, ContentTypeFieldChange{Type: FieldChangeRemoved, FieldID: field.ID})
+		}
+	}
+
+	return changes, nil
+}
+
+// ContentTypeChangeLog returns the field changes between successive snapshots,
+// oldest first. The snapshots are sorted by their creation date.
+func ContentTypeChangeLog(snapshots []ContentTypeSnapshot) ([]ContentTypeChange, error) {
+	sorted := make([]*ContentTypeSnapshot, 0, len(snapshots))
+	for i := range snapshots {
+		sorted = append(sorted, &snapshots[i])
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return snapshotCreatedAt(sorted[i]) < snapshotCreatedAt(sorted[j])
+	})
+
+	var log []ContentTypeChange
+	var previous *ContentTypeSnapshot
+	for _, snapshot := range sorted {
+		var from []*Field
+		if previous != nil {
+			from = previous.ContentTypeSnapshotDetail.Fields
+		}
+
+		fields, err := DiffContentTypeFields(from, snapshot.ContentTypeSnapshotDetail.Fields)
+		if err != nil {
+			return nil, err
+		}
+
+		log = append(log, ContentTypeChange{
+			Snapshot: snapshot,
+			Previous: previous,
+			Fields:   fields,
+		})
+		previous = snapshot
+	}
+
+	return log, nil
+}
+
+// ChangeLog fetches all snapshots of the content type and returns its change log
+func (service *ContentTypesService) ChangeLog(ctx context.Context, env *Environment, contentTypeID string) ([]ContentTypeChange, error) {
+	col, err := service.c.Snapshots.ListContentTypeSnapshots(ctx, env, contentTypeID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := col.collectAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ContentTypeChangeLog(snapshots)
+}
+
+// RestoreFromSnapshot updates the content type to the state of the snapshot and
+// activates it. Fields added after the snapshot was taken are kept but omitted,
+// because fields can only be deleted once they are omitted and activated.
+func (service *ContentTypesService) RestoreFromSnapshot(ctx context.Context, env *Environment, contentTypeID, snapshotID string) (*ContentType, error) {
+	snapshot, err := service.c.Snapshots.GetContentTypeSnapshots(ctx, env, contentTypeID, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+
+	ct, err := service.Get(ctx, env, contentTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	restored := snapshot.ContentTypeSnapshotDetail.ContentType()
+	restored.Sys = ct.Sys
+
+	inSnapshot := map[string]bool{}
+	for _, field := range restored.Fields {
+		inSnapshot[field.ID] = true
+	}
+	for _, field := range ct.Fields {
+		if !inSnapshot[field.ID] {
+			omitted := *field
+			omitted.Omitted = true
+			restored.Fields = append(restored.Fields, &omitted)
+		}
+	}
+
+	if err := service.Upsert(ctx, env, restored); err != nil {
+		return nil, err
+	}
+
+	if err := service.Activate(ctx, env, restored); err != nil {
+		return nil, err
+	}
+
+	return restored, nil
+}
+
+// changedFieldAttributes returns the json names of the attributes that differ
+func changedFieldAttributes(from, to *Field) ([]string, error) {
+	fromDoc, err := fieldDocument(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toDoc, err := fieldDocument(to)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := map[string]bool{}
+	for key := range fromDoc {
+		keys[key] = true
+	}
+	for key := range toDoc {
+		keys[key] = true
+	}
+
+	var attributes []string
+	for key := range keys {
+		if !reflect.DeepEqual(fromDoc[key], toDoc[key]) {
+			attributes = append(attributes, key)
+		}
+	}
+	sort.Strings(attributes)
+
+	return attributes, nil
+}
+
+func fieldDocument(field *Field) (map[string]any, error) {
+	b, err := json.Marshal(field)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+func snapshotCreatedAt(snapshot *ContentTypeSnapshot) string {
+	if snapshot.Sys == nil {
+		return ""
+	}
+
+	return snapshot.Sys.CreatedAt
+}
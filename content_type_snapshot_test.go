@@ -0,0 +1,130 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentTypeSnapshotDetail_ContentType(t *testing.T) {
+	assertions := assert.New(t)
+
+	var snapshot ContentTypeSnapshot
+	err := json.Unmarshal([]byte(readTestData("snapshot_content_type_2.json")), &snapshot)
+	assertions.Nil(err)
+
+	ct := snapshot.ContentTypeSnapshotDetail.ContentType()
+	assertions.Equal("Blog Post", ct.Name)
+	assertions.Equal("title", ct.DisplayField)
+	assertions.Equal(3, len(ct.Fields))
+	assertions.Equal(FieldTypeSymbol, ct.Fields[0].Type)
+	assertions.Equal(1, len(ct.Fields[0].Validations))
+	assertions.Equal(FieldTypeSymbol, ct.Fields[1].Items.Type)
+	assertions.Equal(1, len(ct.Fields[1].Items.Validations))
+	assertions.Equal("Entry", ct.Fields[2].LinkType)
+	assertions.True(ct.Fields[2].Disabled)
+}
+
+func TestContentTypesService_ChangeLog(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/content_types/hfM9RCJIk0wIm06WkEOQY/snapshots")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("snapshot_content_type_history.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	log, err := cma.ContentTypes.ChangeLog(context.Background(), env, "hfM9RCJIk0wIm06WkEOQY")
+	assertions.Nil(err)
+	assertions.Equal(2, len(log))
+
+	assertions.Equal("cat", log[0].Snapshot.Sys.ID)
+	assertions.Nil(log[0].Previous)
+	assertions.Equal([]ContentTypeFieldChange{
+		{Type: FieldChangeAdded, FieldID: "title"},
+		{Type: FieldChangeAdded, FieldID: "body"},
+	}, log[0].Fields)
+
+	assertions.Equal("dog", log[1].Snapshot.Sys.ID)
+	assertions.Equal("cat", log[1].Previous.Sys.ID)
+
+	var changes []string
+	for _, change := range log[1].Fields {
+		changes = append(changes, change.String())
+	}
+	assertions.Equal([]string{
+		"changed title [required type validations]",
+		"added tags",
+		"added author",
+		"removed body",
+	}, changes)
+}
+
+func TestContentTypesService_RestoreFromSnapshot(t *testing.T) {
+	assertions := assert.New(t)
+
+	var restored map[string]interface{}
+	activated := false
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkHeaders(r, assertions)
+
+		ctPath := "/spaces/" + spaceID + "/environments/" + environmentID + "/content_types/hfM9RCJIk0wIm06WkEOQY"
+		switch {
+		case r.Method == "GET" && r.URL.Path == ctPath+"/snapshots/cat":
+			_, _ = fmt.Fprintln(w, readTestData("snapshot_content_type_1.json"))
+		case r.Method == "GET" && r.URL.Path == ctPath:
+			_, _ = fmt.Fprintln(w, readTestData("content_type_blog_post.json"))
+		case r.Method == "PUT" && r.URL.Path == ctPath:
+			assertions.Equal("12", r.Header.Get("X-Contentful-Version"))
+			err := json.NewDecoder(r.Body).Decode(&restored)
+			assertions.Nil(err)
+
+			restored["sys"] = map[string]interface{}{"id": "hfM9RCJIk0wIm06WkEOQY", "version": 13}
+			_ = json.NewEncoder(w).Encode(restored)
+		case r.Method == "PUT" && r.URL.Path == ctPath+"/published":
+			assertions.Equal("13", r.Header.Get("X-Contentful-Version"))
+			activated = true
+			restored["sys"] = map[string]interface{}{"id": "hfM9RCJIk0wIm06WkEOQY", "version": 14}
+			_ = json.NewEncoder(w).Encode(restored)
+		default:
+			assertions.Fail("unexpected request", r.Method+" "+r.URL.Path)
+		}
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	ct, err := cma.ContentTypes.RestoreFromSnapshot(context.Background(), env, "hfM9RCJIk0wIm06WkEOQY", "cat")
+	assertions.Nil(err)
+	assertions.True(activated)
+	assertions.Equal(14, ct.Sys.Version)
+
+	fields := restored["fields"].([]interface{})
+	assertions.Equal(3, len(fields))
+	slug := fields[2].(map[string]interface{})
+	assertions.Equal("slug", slug["id"])
+	assertions.Equal(true, slug["omitted"])
+	assertions.Nil(fields[0].(map[string]interface{})["omitted"])
+}
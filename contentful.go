@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -27,31 +28,50 @@ type Client struct {
 	BaseURL       string
 	Environment   string
 	commonService service
-
-	Spaces             *SpacesService
-	Users              *UsersService
-	Environments       *EnvironmentsService
-	EnvironmentAliases *EnvironmentAliasesService
-	Organizations      *OrganizationsService
-	Roles              *RolesService
-	Memberships        *MembershipsService
-	Snapshots          *SnapshotsService
-	APIKeys            *APIKeyService
-	AccessTokens       *AccessTokensService
-	Assets             *AssetsService
-	ContentTypes       *ContentTypesService
-	Entries            *EntriesService
-	EntryTasks         *EntryTasksService
-	ScheduledActions   *ScheduledActionsService
-	Locales            *LocalesService
-	Webhooks           *WebhooksService
-	WebhookCalls       *WebhookCallsService
-	EditorInterfaces   *EditorInterfacesService
-	Extensions         *ExtensionsService
-	AppDefinitions     *AppDefinitionsService
-	AppInstallations   *AppInstallationsService
-	Usages             *UsagesService
-	Resources          *ResourcesService
+	cache         *Cache
+	flights       *flightGroup
+	rateLimit     *rateLimitGate
+	codec         JSONCodec
+
+	Spaces                  *SpacesService
+	Users                   *UsersService
+	Environments            *EnvironmentsService
+	EnvironmentAliases      *EnvironmentAliasesService
+	Organizations           *OrganizationsService
+	Roles                   *RolesService
+	Memberships             *MembershipsService
+	OrganizationMemberships *OrganizationMembershipsService
+	Teams                   *TeamsService
+	TeamMemberships         *TeamMembershipsService
+	TeamSpaceMemberships    *TeamSpaceMembershipsService
+	Invitations             *InvitationsService
+	Snapshots               *SnapshotsService
+	APIKeys                 *APIKeyService
+	PreviewAPIKeys          *PreviewAPIKeysService
+	AccessTokens            *AccessTokensService
+	Assets                  *AssetsService
+	ContentTypes            *ContentTypesService
+	Entries                 *EntriesService
+	EntryTasks              *EntryTasksService
+	EntryComments           *EntryCommentsService
+	ScheduledActions        *ScheduledActionsService
+	Releases                *ReleasesService
+	ReleaseActions          *ReleaseActionsService
+	Locales                 *LocalesService
+	Webhooks                *WebhooksService
+	WebhookCalls            *WebhookCallsService
+	EditorInterfaces        *EditorInterfacesService
+	Extensions              *ExtensionsService
+	AppDefinitions          *AppDefinitionsService
+	AppInstallations        *AppInstallationsService
+	AppKeys                 *AppKeysService
+	AppAccessTokens         *AppAccessTokensService
+	AppSigningSecrets       *AppSigningSecretsService
+	AppEventSubscriptions   *AppEventSubscriptionsService
+	AppActions              *AppActionsService
+	Usages                  *UsagesService
+	Resources               *ResourcesService
+	Sync                    *SyncService
 }
 
 type service struct {
@@ -61,10 +81,11 @@ type service struct {
 // NewCMA returns a CMA client
 func NewCMA(token string) *Client {
 	c := &Client{
-		client: http.DefaultClient,
-		api:    "CMA",
-		token:  token,
-		Debug:  false,
+		client:    http.DefaultClient,
+		rateLimit: &rateLimitGate{},
+		api:       "CMA",
+		token:     token,
+		Debug:     false,
 		Headers: map[string]string{
 			"Authorization":           fmt.Sprintf("Bearer %s", token),
 			"Content-Type":            "application/vnd.contentful.management.v1+json",
@@ -82,14 +103,23 @@ func NewCMA(token string) *Client {
 	c.Organizations = (*OrganizationsService)(&c.commonService)
 	c.Roles = (*RolesService)(&c.commonService)
 	c.Memberships = (*MembershipsService)(&c.commonService)
+	c.OrganizationMemberships = (*OrganizationMembershipsService)(&c.commonService)
+	c.Teams = (*TeamsService)(&c.commonService)
+	c.TeamMemberships = (*TeamMembershipsService)(&c.commonService)
+	c.TeamSpaceMemberships = (*TeamSpaceMembershipsService)(&c.commonService)
+	c.Invitations = (*InvitationsService)(&c.commonService)
 	c.Snapshots = (*SnapshotsService)(&c.commonService)
 	c.APIKeys = (*APIKeyService)(&c.commonService)
+	c.PreviewAPIKeys = (*PreviewAPIKeysService)(&c.commonService)
 	c.AccessTokens = (*AccessTokensService)(&c.commonService)
 	c.Assets = (*AssetsService)(&c.commonService)
 	c.ContentTypes = (*ContentTypesService)(&c.commonService)
 	c.Entries = (*EntriesService)(&c.commonService)
 	c.EntryTasks = (*EntryTasksService)(&c.commonService)
+	c.EntryComments = (*EntryCommentsService)(&c.commonService)
 	c.ScheduledActions = (*ScheduledActionsService)(&c.commonService)
+	c.Releases = (*ReleasesService)(&c.commonService)
+	c.ReleaseActions = (*ReleaseActionsService)(&c.commonService)
 	c.Locales = (*LocalesService)(&c.commonService)
 	c.Webhooks = (*WebhooksService)(&c.commonService)
 	c.WebhookCalls = (*WebhookCallsService)(&c.commonService)
@@ -97,6 +127,11 @@ func NewCMA(token string) *Client {
 	c.Extensions = (*ExtensionsService)(&c.commonService)
 	c.AppDefinitions = (*AppDefinitionsService)(&c.commonService)
 	c.AppInstallations = (*AppInstallationsService)(&c.commonService)
+	c.AppKeys = (*AppKeysService)(&c.commonService)
+	c.AppAccessTokens = (*AppAccessTokensService)(&c.commonService)
+	c.AppSigningSecrets = (*AppSigningSecretsService)(&c.commonService)
+	c.AppEventSubscriptions = (*AppEventSubscriptionsService)(&c.commonService)
+	c.AppActions = (*AppActionsService)(&c.commonService)
 	c.Usages = (*UsagesService)(&c.commonService)
 	return c
 }
@@ -104,10 +139,11 @@ func NewCMA(token string) *Client {
 // NewCDA returns a CDA client
 func NewCDA(token string) *Client {
 	c := &Client{
-		client: http.DefaultClient,
-		api:    "CDA",
-		token:  token,
-		Debug:  false,
+		client:    http.DefaultClient,
+		rateLimit: &rateLimitGate{},
+		api:       "CDA",
+		token:     token,
+		Debug:     false,
 		Headers: map[string]string{
 			"Authorization":           "Bearer " + token,
 			"Content-Type":            "application/vnd.contentful.delivery.v1+json",
@@ -125,6 +161,7 @@ func NewCDA(token string) *Client {
 	c.Entries = (*EntriesService)(&c.commonService)
 	c.Locales = (*LocalesService)(&c.commonService)
 	c.Webhooks = (*WebhooksService)(&c.commonService)
+	c.Sync = (*SyncService)(&c.commonService)
 
 	return c
 }
@@ -132,10 +169,11 @@ func NewCDA(token string) *Client {
 // NewCPA returns a CPA client
 func NewCPA(token string) *Client {
 	c := &Client{
-		client: http.DefaultClient,
-		Debug:  false,
-		api:    "CPA",
-		token:  token,
+		client:    http.DefaultClient,
+		rateLimit: &rateLimitGate{},
+		Debug:     false,
+		api:       "CPA",
+		token:     token,
 		Headers: map[string]string{
 			"Authorization": "Bearer " + token,
 		},
@@ -149,6 +187,7 @@ func NewCPA(token string) *Client {
 	c.Entries = &EntriesService{c: c}
 	c.Locales = &LocalesService{c: c}
 	c.Webhooks = &WebhooksService{c: c}
+	c.Sync = &SyncService{c: c}
 
 	return c
 }
@@ -156,10 +195,11 @@ func NewCPA(token string) *Client {
 // NewResourceClient returns a client for the resource/uploads endpoints
 func NewResourceClient(token string) *Client {
 	c := &Client{
-		client: http.DefaultClient,
-		api:    "URC",
-		Debug:  false,
-		token:  token,
+		client:    http.DefaultClient,
+		rateLimit: &rateLimitGate{},
+		api:       "URC",
+		Debug:     false,
+		token:     token,
 		Headers: map[string]string{
 			"Authorization": "Bearer " + token,
 		},
@@ -219,59 +259,168 @@ func (c *Client) newRequest(ctx context.Context, method, path string, query url.
 }
 
 func (c *Client) do(req *http.Request, v interface{}) error {
-	if c.Debug {
-		command, _ := http2curl.GetCurlCommand(req)
-		fmt.Println(command)
+	if c.flights != nil && req.Method == http.MethodGet {
+		b, err := c.flights.do(req.Context(), cacheKey(req), func(ctx context.Context) ([]byte, error) {
+			return c.send(req.WithContext(ctx))
+		})
+		if err != nil {
+			return err
+		}
+
+		return c.decode(b, v)
 	}
 
-	res, err := c.client.Do(req)
+	b, err := c.send(req)
 	if err != nil {
 		return err
 	}
-	defer res.Body.Close()
 
-	if res.StatusCode >= 200 && res.StatusCode < 400 {
-		// Upload/Create Resource response cannot be decoded
-		if !(c.api == "URC" && req.Method == "POST") {
-			if v != nil {
-				b, err := io.ReadAll(res.Body)
-				if err != nil {
-					return err
-				}
-				err = json.NewDecoder(bytes.NewReader(b)).Decode(v)
-				if err != nil {
-					return err
-				}
-			}
+	return c.decode(b, v)
+}
+
+// send makes the request and returns the response body, nil for responses which cannot be decoded
+func (c *Client) send(req *http.Request) ([]byte, error) {
+	var cached *CacheEntry
+	if c.cache != nil {
+		entry, fresh := c.cache.lookup(req)
+		if fresh {
+			return entry.Body, nil
 		}
 
-		return nil
+		// revalidate on a copy, requests of collections are sent again for the next pages
+		if entry != nil {
+			req = req.Clone(req.Context())
+			req.Header.Set("If-None-Match", entry.ETag)
+		}
+		cached = entry
 	}
 
-	// parse api response
-	apiError := c.handleError(req, res)
+	res, err := c.open(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
 
-	// return apiError if it is not rate limit error
-	if _, ok := apiError.(RateLimitExceededError); !ok {
-		return apiError
+	if res.StatusCode == http.StatusNotModified && cached != nil {
+		c.cache.revalidated(req, res, cached)
+		return cached.Body, nil
 	}
 
-	resetHeader := res.Header.Get("x-contentful-ratelimit-reset")
+	// uploads do not change the content of the delivery or management responses
+	if c.cache != nil && c.api != "URC" {
+		c.cache.written(req)
+	}
 
-	// return apiError if Ratelimit-Reset header is not presented
-	if resetHeader == "" {
-		return apiError
+	// Upload/Create Resource response cannot be decoded
+	if c.api == "URC" && req.Method == "POST" {
+		return nil, nil
 	}
 
-	// wait X-Contentful-Ratelimit-Reset amount of seconds
-	waitSeconds, err := strconv.Atoi(resetHeader)
+	b, err := io.ReadAll(res.Body)
 	if err != nil {
-		return apiError
+		return nil, err
 	}
+	if c.cache != nil {
+		c.cache.save(req, res, b)
+	}
+
+	return b, nil
+}
+
+// open makes the request and returns the response of a successful request,
+// the caller has to close its body. Rate limited requests are retried after
+// the reset the API sent.
+func (c *Client) open(req *http.Request) (*http.Response, error) {
+	pacing := pacingGate(req.Context())
+
+	for retries := 0; ; retries++ {
+		if c.Debug {
+			command, _ := http2curl.GetCurlCommand(req)
+			fmt.Println(command)
+		}
+
+		if err := c.rateLimit.wait(req.Context()); err != nil {
+			return nil, err
+		}
+
+		if err := pacing.wait(req.Context()); err != nil {
+			return nil, err
+		}
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		pacing.observe(res.Header)
+
+		if res.StatusCode >= 200 && res.StatusCode < 400 {
+			return res, nil
+		}
 
-	time.Sleep(time.Second * time.Duration(waitSeconds))
+		// parse api response
+		apiError := c.handleError(req, res)
+		res.Body.Close()
+
+		// return apiError if it is not rate limit error
+		if _, ok := apiError.(RateLimitExceededError); !ok {
+			return nil, apiError
+		}
+
+		if retries >= maxRateLimitRetries {
+			return nil, apiError
+		}
+
+		resetHeader := res.Header.Get("x-contentful-ratelimit-reset")
+
+		// return apiError if Ratelimit-Reset header is not presented
+		if resetHeader == "" {
+			return nil, apiError
+		}
+
+		waitSeconds, err := strconv.Atoi(resetHeader)
+		if err != nil {
+			return nil, apiError
+		}
+
+		// requests with a body which can not be read again are not retried
+		req, err = rewindBody(req)
+		if err != nil {
+			return nil, apiError
+		}
+
+		// wait X-Contentful-Ratelimit-Reset amount of seconds, other requests of the client wait as well
+		c.rateLimit.pause(time.Second * time.Duration(waitSeconds))
+	}
+}
+
+// rewindBody returns a copy of req with a new body for a retry
+func rewindBody(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+
+	if req.GetBody == nil {
+		return nil, errors.New("request body can not be read again")
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	retry := req.Clone(req.Context())
+	retry.Body = body
+
+	return retry, nil
+}
+
+// decode decodes a response body with the json codec of the client
+func (c *Client) decode(b []byte, v interface{}) error {
+	if v == nil || b == nil {
+		return nil
+	}
 
-	return c.do(req, v)
+	return c.jsonCodec().NewDecoder(bytes.NewReader(b)).Decode(v)
 }
 
 func (c *Client) handleError(req *http.Request, res *http.Response) error {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -526,3 +527,99 @@ func TestBackoffForPerSecondLimiting(t *testing.T) {
 	assertions.Equal(space.Name, "Contentful Example API")
 	assertions.Equal(space.Sys.ID, "id1")
 }
+
+func TestRateLimitRetries(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	var bodies []string
+	limited := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+
+		if limited > 0 {
+			limited--
+			w.Header().Set("X-Contentful-Ratelimit-Reset", "0")
+			w.WriteHeader(429)
+			_, _ = w.Write([]byte(readTestData("error_ratelimit.json")))
+			return
+		}
+
+		_, _ = w.Write([]byte(readTestData("space-1.json")))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	// the body is sent again with every retry
+	limited = 2
+	err = cma.Spaces.Upsert(context.Background(), &Space{Name: "Contentful Example API"})
+	assertions.Nil(err)
+	assertions.Equal(3, len(bodies))
+	assertions.Equal(bodies[0], bodies[2])
+	assertions.Contains(bodies[2], "Contentful Example API")
+
+	// retries are capped
+	bodies = nil
+	limited = maxRateLimitRetries + 1
+	_, err = cma.Spaces.Get(context.Background(), "id1")
+	var rateLimitErr RateLimitExceededError
+	assertions.True(errors.As(err, &rateLimitErr))
+	assertions.Equal(maxRateLimitRetries+1, len(bodies))
+}
+
+func TestRateLimitSecondRemaining(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Contentful-Ratelimit-Second-Remaining", "0")
+		_, _ = w.Write([]byte(readTestData("space-1.json")))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	// only FetchAll paces its requests
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err = cma.Spaces.Get(context.Background(), "id1")
+		assertions.Nil(err)
+	}
+	assertions.True(time.Since(start) < 500*time.Millisecond)
+}
+
+func releaseFromTestFile(fileName string) (*Release, error) {
+	content := readTestData(fileName)
+
+	var release Release
+	err := json.NewDecoder(strings.NewReader(content)).Decode(&release)
+	if err != nil {
+		return nil, err
+	}
+
+	return &release, nil
+}
+
+func entrySnapshotFromTestData(fileName string) (*EntrySnapshot, error) {
+	content := readTestData(fileName)
+
+	var snapshot EntrySnapshot
+	err := json.NewDecoder(strings.NewReader(content)).Decode(&snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
@@ -4,39 +4,109 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
+	"strconv"
 )
 
 // EditorInterfacesService service
 type EditorInterfacesService service
 
+// noinspection GoUnusedConst
+const (
+	WidgetNamespaceBuiltin        = "builtin"
+	WidgetNamespaceExtension      = "extension"
+	WidgetNamespaceApp            = "app"
+	WidgetNamespaceSidebarBuiltin = "sidebar-builtin"
+	WidgetNamespaceEditorBuiltin  = "editor-builtin"
+)
+
 // EditorInterface model
 type EditorInterface struct {
-	Sys      *Sys       `json:"sys"`
-	Controls []Controls `json:"controls"`
-	SideBar  []Sidebar  `json:"sidebar"`
+	Sys           *Sys               `json:"sys"`
+	Controls      []Controls         `json:"controls"`
+	SideBar       []Sidebar          `json:"sidebar"`
+	Editors       []Editor           `json:"editors,omitempty"`
+	EditorLayout  []EditorLayoutItem `json:"editorLayout,omitempty"`
+	GroupControls []GroupControl     `json:"groupControls,omitempty"`
 }
 
 // Controls model
 type Controls struct {
-	FieldID         string            `json:"fieldId"`
-	WidgetNameSpace string            `json:"widgetNamespace"`
-	WidgetID        string            `json:"widgetId"`
-	Settings        map[string]string `json:"settings,omitempty"`
+	FieldID         string         `json:"fieldId"`
+	WidgetNameSpace string         `json:"widgetNamespace"`
+	WidgetID        string         `json:"widgetId"`
+	Settings        map[string]any `json:"settings,omitempty"`
 }
 
 // Sidebar model
 type Sidebar struct {
-	WidgetNameSpace string            `json:"widgetNamespace"`
-	WidgetID        string            `json:"widgetId"`
-	Settings        map[string]string `json:"settings,omitempty"`
-	Disabled        bool              `json:"disabled"`
+	WidgetNameSpace string         `json:"widgetNamespace"`
+	WidgetID        string         `json:"widgetId"`
+	Settings        map[string]any `json:"settings,omitempty"`
+	Disabled        bool           `json:"disabled"`
+}
+
+// Editor model, an entry editor shown as tab next to or instead of the default editor
+type Editor struct {
+	WidgetNameSpace string         `json:"widgetNamespace"`
+	WidgetID        string         `json:"widgetId"`
+	Settings        map[string]any `json:"settings,omitempty"`
+	Disabled        bool           `json:"disabled,omitempty"`
+}
+
+// EditorLayoutItem model, either a field reference or a group of items
+type EditorLayoutItem struct {
+	FieldID string             `json:"fieldId,omitempty"`
+	GroupID string             `json:"groupId,omitempty"`
+	Name    string             `json:"name,omitempty"`
+	Items   []EditorLayoutItem `json:"items,omitempty"`
+}
+
+// GroupControl model, the widget used to render a group of the editor layout
+type GroupControl struct {
+	GroupID         string         `json:"groupId"`
+	WidgetNameSpace string         `json:"widgetNamespace,omitempty"`
+	WidgetID        string         `json:"widgetId,omitempty"`
+	Settings        map[string]any `json:"settings,omitempty"`
+}
+
+// NewEditorLayoutGroup returns a layout group containing the given fields
+func NewEditorLayoutGroup(groupID, name string, fieldIDs ...string) EditorLayoutItem {
+	group := EditorLayoutItem{GroupID: groupID, Name: name, Items: []EditorLayoutItem{}}
+	for _, fieldID := range fieldIDs {
+		group.Items = append(group.Items, EditorLayoutItem{FieldID: fieldID})
+	}
+
+	return group
+}
+
+// GetVersion returns entity version
+func (editorInterface *EditorInterface) GetVersion() int {
+	version := 1
+	if editorInterface.Sys != nil {
+		version = editorInterface.Sys.Version
+	}
+
+	return version
+}
+
+// Control returns the control of the field or nil
+func (editorInterface *EditorInterface) Control(fieldID string) *Controls {
+	for i := range editorInterface.Controls {
+		if editorInterface.Controls[i].FieldID == fieldID {
+			return &editorInterface.Controls[i]
+		}
+	}
+
+	return nil
 }
 
 // List returns an EditorInterface collection
-func (service *EditorInterfacesService) List(ctx context.Context, spaceID string, query *Query) (*Collection[EditorInterface], error) {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/editor_interface", spaceID, service.c.Environment)
+func (service *EditorInterfacesService) List(ctx context.Context, env *Environment, query *Query) (*Collection[EditorInterface], error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/editor_interface", env.Sys.Space.Sys.ID, env.Sys.ID)
 
 	req, err := service.c.newRequest(ctx, "GET", path, nil, nil)
 	if err != nil {
@@ -52,8 +122,8 @@ func (service *EditorInterfacesService) List(ctx context.Context, spaceID string
 }
 
 // Get returns a single EditorInterface
-func (service *EditorInterfacesService) Get(ctx context.Context, spaceID, contentTypeID string) (*EditorInterface, error) {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/editor_interface", spaceID, service.c.Environment, contentTypeID)
+func (service *EditorInterfacesService) Get(ctx context.Context, env *Environment, contentTypeID string) (*EditorInterface, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/editor_interface", env.Sys.Space.Sys.ID, env.Sys.ID, contentTypeID)
 	query := url.Values{}
 	method := "GET"
 
@@ -63,32 +133,37 @@ func (service *EditorInterfacesService) Get(ctx context.Context, spaceID, conten
 	}
 
 	var editorInterface EditorInterface
-	if ok := service.c.do(req, &editorInterface); ok != nil {
+	if err := service.c.do(req, &editorInterface); err != nil {
 		return nil, err
 	}
 
 	return &editorInterface, err
 }
 
-// Update updates an editor interface
-func (service *EditorInterfacesService) Update(ctx context.Context, spaceID, contentTypeID string, e *EditorInterface) error {
+// Update updates an editor interface. The content type id is read from the
+// editor interface when contentTypeID is empty.
+func (service *EditorInterfacesService) Update(ctx context.Context, env *Environment, contentTypeID string, e *EditorInterface) error {
+	if contentTypeID == "" && e.Sys != nil && e.Sys.ContentType != nil && e.Sys.ContentType.Sys != nil {
+		contentTypeID = e.Sys.ContentType.Sys.ID
+	}
+
+	if contentTypeID == "" {
+		return errors.New("editor interface has no content type")
+	}
+
 	bytesArray, err := json.Marshal(e)
 	if err != nil {
 		return err
 	}
 
-	var path string
-	var method string
+	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/editor_interface", env.Sys.Space.Sys.ID, env.Sys.ID, contentTypeID)
 
-	if contentTypeID != "" {
-		path = fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/editor_interface", spaceID, service.c.Environment, contentTypeID)
-		method = "PUT"
-	}
-
-	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
+	req, err := service.c.newRequest(ctx, http.MethodPut, path, nil, bytes.NewReader(bytesArray))
 	if err != nil {
 		return err
 	}
 
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(e.GetVersion()))
+
 	return service.c.do(req, e)
 }
@@ -17,7 +17,7 @@ func TestEditorInterfacesService_List(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/editor_interface")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/editor_interface")
 
 		checkHeaders(r, assertions)
 
@@ -33,7 +33,7 @@ func TestEditorInterfacesService_List(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	collection, err := cma.EditorInterfaces.List(context.Background(), spaceID, nil)
+	collection, err := cma.EditorInterfaces.List(context.Background(), env, nil)
 	assertions.Nil(err)
 
 	interfaces := collection.Items
@@ -48,7 +48,7 @@ func TestEditorInterfacesService_Get(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/content_types/hfM9RCJIk0wIm06WkEOQY/editor_interface")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/content_types/hfM9RCJIk0wIm06WkEOQY/editor_interface")
 
 		checkHeaders(r, assertions)
 
@@ -64,7 +64,7 @@ func TestEditorInterfacesService_Get(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	editorInterface, err := cma.EditorInterfaces.Get(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY")
+	editorInterface, err := cma.EditorInterfaces.Get(context.Background(), env, "hfM9RCJIk0wIm06WkEOQY")
 	assertions.Nil(err)
 	assertions.Equal("name", editorInterface.Controls[0].FieldID)
 	assertions.Equal("extension", editorInterface.SideBar[0].WidgetNameSpace)
@@ -76,7 +76,7 @@ func TestEditorInterfacesService_Get_2(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/content_types/hfM9RCJIk0wIm06WkEOQY/editor_interface")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/content_types/hfM9RCJIk0wIm06WkEOQY/editor_interface")
 
 		checkHeaders(r, assertions)
 
@@ -92,8 +92,8 @@ func TestEditorInterfacesService_Get_2(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	_, err = cma.EditorInterfaces.Get(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY")
-	assertions.Nil(err)
+	_, err = cma.EditorInterfaces.Get(context.Background(), env, "hfM9RCJIk0wIm06WkEOQY")
+	assertions.NotNil(err)
 }
 
 func TestEditorInterfacesService_Update(t *testing.T) {
@@ -102,7 +102,7 @@ func TestEditorInterfacesService_Update(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/content_types/hfM9RCJIk0wIm06WkEOQY/editor_interface")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/content_types/hfM9RCJIk0wIm06WkEOQY/editor_interface")
 		checkHeaders(r, assertions)
 
 		var payload map[string]interface{}
@@ -131,7 +131,99 @@ func TestEditorInterfacesService_Update(t *testing.T) {
 
 	editorInterface.Controls[0].WidgetID = "changed id"
 
-	err = cma.EditorInterfaces.Update(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", editorInterface)
+	err = cma.EditorInterfaces.Update(context.Background(), env, "hfM9RCJIk0wIm06WkEOQY", editorInterface)
 	assertions.Nil(err)
 	assertions.Equal("changed id", editorInterface.Controls[0].WidgetID)
 }
+
+func TestEditorInterfacesService_Get_LayoutAndEditors(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/content_types/blogPost/editor_interface")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("editor_interface_layout.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	editorInterface, err := cma.EditorInterfaces.Get(context.Background(), env, "blogPost")
+	assertions.Nil(err)
+
+	assertions.Equal(float64(5), editorInterface.Control("rating").Settings["stars"])
+	assertions.Equal(true, editorInterface.Control("related").Settings["bulkEditing"])
+	assertions.Nil(editorInterface.Control("missing"))
+
+	assertions.Equal(2, len(editorInterface.Editors))
+	assertions.Equal(WidgetNamespaceApp, editorInterface.Editors[1].WidgetNameSpace)
+	assertions.Equal(true, editorInterface.Editors[1].Settings["showPreview"])
+
+	assertions.Equal(2, len(editorInterface.EditorLayout))
+	assertions.Equal("Settings", editorInterface.EditorLayout[1].Name)
+	assertions.Equal("featured", editorInterface.EditorLayout[1].Items[1].FieldID)
+	assertions.Equal("topLevelTab", editorInterface.GroupControls[0].WidgetID)
+}
+
+func TestEditorInterfacesService_Update_LayoutAndVersion(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "PUT")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/content_types/blogPost/editor_interface")
+		assertions.Equal("3", r.Header.Get("X-Contentful-Version"))
+		checkHeaders(r, assertions)
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+
+		layout := payload["editorLayout"].([]any)
+		assertions.Equal(map[string]any{
+			"groupId": "seo",
+			"name":    "SEO",
+			"items":   []any{map[string]any{"fieldId": "title"}},
+		}, layout[2])
+
+		controls := payload["controls"].([]any)
+		assertions.Equal(map[string]any{"stars": float64(10)}, controls[1].(map[string]any)["settings"])
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("editor_interface_layout.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	editorInterface, err := editorInterfaceFromTestFile("editor_interface_layout.json")
+	assertions.Nil(err)
+
+	editorInterface.Control("rating").Settings["stars"] = 10
+	editorInterface.EditorLayout = append(editorInterface.EditorLayout, NewEditorLayoutGroup("seo", "SEO", "title"))
+
+	err = cma.EditorInterfaces.Update(context.Background(), env, "", editorInterface)
+	assertions.Nil(err)
+	assertions.Equal(2, len(editorInterface.EditorLayout))
+}
+
+func TestEditorInterfacesService_Update_WithoutContentType(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma = NewCMA(CMAToken)
+
+	err := cma.EditorInterfaces.Update(context.Background(), env, "", &EditorInterface{})
+	assertions.NotNil(err)
+}
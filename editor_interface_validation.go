@@ -0,0 +1,239 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+)
+
+// builtinWidgets maps the builtin control widgets to the field types they can edit
+var builtinWidgets = map[string][]string{
+	"singleLine":         {"Symbol", "Text"},
+	"multipleLine":       {"Text"},
+	"markdown":           {"Text"},
+	"urlEditor":          {"Symbol"},
+	"slugEditor":         {"Symbol"},
+	"dropdown":           {"Symbol", "Text", "Integer", "Number"},
+	"radio":              {"Symbol", "Text", "Integer", "Number"},
+	"numberEditor":       {"Integer", "Number"},
+	"rating":             {"Integer", "Number"},
+	"boolean":            {"Boolean"},
+	"datePicker":         {"Date"},
+	"locationEditor":     {"Location"},
+	"objectEditor":       {"Object"},
+	"richTextEditor":     {"RichText"},
+	"listInput":          {"Array<Symbol>"},
+	"checkbox":           {"Array<Symbol>"},
+	"tagEditor":          {"Array<Symbol>"},
+	"entryLinkEditor":    {"Link<Entry>"},
+	"entryCardEditor":    {"Link<Entry>"},
+	"entryLinksEditor":   {"Array<Link<Entry>>"},
+	"entryCardsEditor":   {"Array<Link<Entry>>"},
+	"assetLinkEditor":    {"Link<Asset>"},
+	"assetLinksEditor":   {"Array<Link<Asset>>"},
+	"assetGalleryEditor": {"Array<Link<Asset>>"},
+}
+
+// WidgetCatalog lists the control widgets of an environment and the field types they support
+type WidgetCatalog struct {
+	widgets map[string]map[string][]string
+}
+
+// NewWidgetCatalog returns a catalog of the builtin widgets, the field
+// extensions and the apps with an entry-field location
+func NewWidgetCatalog(extensions []Extension, appDefinitions []AppDefinition) *WidgetCatalog {
+	catalog := &WidgetCatalog{
+		widgets: map[string]map[string][]string{
+			WidgetNamespaceBuiltin:   builtinWidgets,
+			WidgetNamespaceExtension: {},
+			WidgetNamespaceApp:       {},
+		},
+	}
+
+	for _, extension := range extensions {
+		if extension.Sys == nil {
+			continue
+		}
+
+		types := []string{}
+		for _, fieldType := range extension.Extension.FieldTypes {
+			types = append(types, extensionFieldTypeKey(fieldType))
+		}
+		catalog.widgets[WidgetNamespaceExtension][extension.Sys.ID] = types
+	}
+
+	for _, definition := range appDefinitions {
+		if definition.Sys == nil {
+			continue
+		}
+
+		types := []string{}
+		for _, location := range definition.Locations {
+			if location.Location != AppLocationEntryField {
+				continue
+			}
+
+			for _, fieldType := range location.FieldTypes {
+				key := fieldTypeKey(fieldType.Type, fieldType.LinkType)
+				if fieldType.Items != nil {
+					key = "Array<" + fieldTypeKey(fieldType.Items.Type, fieldType.Items.LinkType) + ">"
+				}
+				types = append(types, key)
+			}
+		}
+		catalog.widgets[WidgetNamespaceApp][definition.Sys.ID] = types
+	}
+
+	return catalog
+}
+
+// Supports reports whether the widget exists and can edit the field
+func (catalog *WidgetCatalog) Supports(namespace, widgetID string, field *Field) bool {
+	types, ok := catalog.widgets[namespace][widgetID]
+	if !ok {
+		return false
+	}
+
+	key := contentTypeFieldKey(field)
+	for _, t := range types {
+		if t == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Has reports whether the widget exists in the catalog
+func (catalog *WidgetCatalog) Has(namespace, widgetID string) bool {
+	_, ok := catalog.widgets[namespace][widgetID]
+	return ok
+}
+
+// EditorInterfaceProblem describes an invalid part of an editor interface
+type EditorInterfaceProblem struct {
+	FieldID         string
+	WidgetNameSpace string
+	WidgetID        string
+	Message         string
+}
+
+func (p EditorInterfaceProblem) String() string {
+	if p.FieldID == "" {
+		return fmt.Sprintf("%s/%s: %s", p.WidgetNameSpace, p.WidgetID, p.Message)
+	}
+
+	return fmt.Sprintf("field %s (%s/%s): %s", p.FieldID, p.WidgetNameSpace, p.WidgetID, p.Message)
+}
+
+// ValidateEditorInterface checks that every control references a field of the
+// content type and a widget of the catalog able to edit the field type. It
+// returns an EditorInterfaceValidationError listing all problems.
+func ValidateEditorInterface(editorInterface *EditorInterface, contentType *ContentType, catalog *WidgetCatalog) error {
+	fields := map[string]*Field{}
+	for _, field := range contentType.Fields {
+		fields[field.ID] = field
+	}
+
+	var problems []EditorInterfaceProblem
+
+	for _, control := range editorInterface.Controls {
+		problem := EditorInterfaceProblem{
+			FieldID:         control.FieldID,
+			WidgetNameSpace: control.WidgetNameSpace,
+			WidgetID:        control.WidgetID,
+		}
+
+		field, ok := fields[control.FieldID]
+		switch {
+		case !ok:
+			problem.Message = "field does not exist"
+		case control.WidgetID == "":
+			continue
+		case !catalog.Has(control.WidgetNameSpace, control.WidgetID):
+			problem.Message = "widget does not exist"
+		case !catalog.Supports(control.WidgetNameSpace, control.WidgetID, field):
+			problem.Message = "widget does not support field type " + contentTypeFieldKey(field)
+		default:
+			continue
+		}
+
+		problems = append(problems, problem)
+	}
+
+	var walk func(items []EditorLayoutItem)
+	walk = func(items []EditorLayoutItem) {
+		for _, item := range items {
+			if item.FieldID != "" {
+				if _, ok := fields[item.FieldID]; !ok {
+					problems = append(problems, EditorInterfaceProblem{FieldID: item.FieldID, Message: "field in editor layout does not exist"})
+				}
+			}
+			walk(item.Items)
+		}
+	}
+	walk(editorInterface.EditorLayout)
+
+	if len(problems) > 0 {
+		return EditorInterfaceValidationError{Problems: problems}
+	}
+
+	return nil
+}
+
+// WidgetCatalog returns the widget catalog of the environment with its
+// extensions. App definitions belong to the organization, pass the ones
+// installed in the environment.
+func (service *EditorInterfacesService) WidgetCatalog(ctx context.Context, env *Environment, appDefinitions ...AppDefinition) (*WidgetCatalog, error) {
+	col, err := service.c.Extensions.List(ctx, env, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	extensions, err := col.collectAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWidgetCatalog(extensions, appDefinitions), nil
+}
+
+// Validate fetches the content type and the extensions of the environment and
+// validates the editor interface against them
+func (service *EditorInterfacesService) Validate(ctx context.Context, env *Environment, contentTypeID string, editorInterface *EditorInterface, appDefinitions ...AppDefinition) error {
+	contentType, err := service.c.ContentTypes.Get(ctx, env, contentTypeID)
+	if err != nil {
+		return err
+	}
+
+	catalog, err := service.WidgetCatalog(ctx, env, appDefinitions...)
+	if err != nil {
+		return err
+	}
+
+	return ValidateEditorInterface(editorInterface, contentType, catalog)
+}
+
+// contentTypeFieldKey returns the type of the field as matched by the catalog, e.g. Array<Link<Entry>>
+func contentTypeFieldKey(field *Field) string {
+	if field.Type == FieldTypeArray && field.Items != nil {
+		return "Array<" + fieldTypeKey(field.Items.Type, field.Items.LinkType) + ">"
+	}
+
+	return fieldTypeKey(field.Type, field.LinkType)
+}
+
+func extensionFieldTypeKey(fieldType FieldTypes) string {
+	if fieldType.Type == FieldTypeArray && fieldType.Items != nil {
+		return "Array<" + fieldTypeKey(fieldType.Items.Type, fieldType.Items.LinkType) + ">"
+	}
+
+	return fieldTypeKey(fieldType.Type, fieldType.LinkType)
+}
+
+func fieldTypeKey(fieldType, linkType string) string {
+	if fieldType == FieldTypeLink && linkType != "" {
+		return "Link<" + linkType + ">"
+	}
+
+	return fieldType
+}
@@ -0,0 +1,126 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateEditorInterface(t *testing.T) {
+	assertions := assert.New(t)
+
+	editorInterface, err := editorInterfaceFromTestFile("editor_interface_layout.json")
+	assertions.Nil(err)
+
+	contentType, err := contentTypeFromTestData("content_type_widgets.json")
+	assertions.Nil(err)
+
+	catalog := NewWidgetCatalog(nil, nil)
+	assertions.Nil(ValidateEditorInterface(editorInterface, contentType, catalog))
+
+	editorInterface.Controls = append(editorInterface.Controls,
+		Controls{FieldID: "missing", WidgetNameSpace: WidgetNamespaceBuiltin, WidgetID: "singleLine"},
+		Controls{FieldID: "color", WidgetNameSpace: WidgetNamespaceBuiltin, WidgetID: "entryLinkEditor"},
+		Controls{FieldID: "color", WidgetNameSpace: WidgetNamespaceExtension, WidgetID: "colorPicker"},
+	)
+	editorInterface.EditorLayout[0].Items = append(editorInterface.EditorLayout[0].Items, EditorLayoutItem{FieldID: "removed"})
+
+	err = ValidateEditorInterface(editorInterface, contentType, catalog)
+
+	var validationErr EditorInterfaceValidationError
+	assertions.True(errors.As(err, &validationErr))
+	assertions.Equal(4, len(validationErr.Problems))
+	assertions.Equal("field missing (builtin/singleLine): field does not exist", validationErr.Problems[0].String())
+	assertions.Equal("widget does not support field type Symbol", validationErr.Problems[1].Message)
+	assertions.Equal("widget does not exist", validationErr.Problems[2].Message)
+	assertions.Equal("removed", validationErr.Problems[3].FieldID)
+}
+
+func TestWidgetCatalog(t *testing.T) {
+	assertions := assert.New(t)
+
+	extension, err := extensionFromTestFile("extension_1.json")
+	assertions.Nil(err)
+
+	app := AppDefinition{
+		Sys: &Sys{ID: "app_definition_id"},
+		Locations: []Locations{
+			NewEntryFieldLocation(AppFieldType{Type: "Array", Items: &AppFieldTypeItems{Type: "Link", LinkType: "Asset"}}),
+		},
+	}
+
+	catalog := NewWidgetCatalog([]Extension{*extension}, []AppDefinition{app})
+
+	symbol := &Field{ID: "title", Type: FieldTypeSymbol}
+	images := &Field{ID: "images", Type: FieldTypeArray, Items: &FieldTypeArrayItem{Type: FieldTypeLink, LinkType: "Asset"}}
+	entries := &Field{ID: "entries", Type: FieldTypeArray, Items: &FieldTypeArrayItem{Type: FieldTypeLink, LinkType: "Entry"}}
+
+	assertions.True(catalog.Supports(WidgetNamespaceBuiltin, "singleLine", symbol))
+	assertions.False(catalog.Supports(WidgetNamespaceBuiltin, "markdown", symbol))
+	assertions.True(catalog.Supports(WidgetNamespaceBuiltin, "assetGalleryEditor", images))
+	assertions.False(catalog.Supports(WidgetNamespaceBuiltin, "assetGalleryEditor", entries))
+
+	assertions.True(catalog.Supports(WidgetNamespaceExtension, "0xvkPW9FdQ1kkWlWZ8ga4x", symbol))
+	assertions.False(catalog.Supports(WidgetNamespaceExtension, "0xvkPW9FdQ1kkWlWZ8ga4x", images))
+
+	assertions.True(catalog.Supports(WidgetNamespaceApp, "app_definition_id", images))
+	assertions.False(catalog.Supports(WidgetNamespaceApp, "app_definition_id", symbol))
+	assertions.False(catalog.Has(WidgetNamespaceApp, "other_app"))
+}
+
+func TestEditorInterfacesService_Validate(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		checkHeaders(r, assertions)
+
+		switch r.URL.Path {
+		case "/spaces/" + spaceID + "/environments/" + environmentID + "/content_types/blogPost":
+			w.WriteHeader(200)
+			_, _ = fmt.Fprintln(w, readTestData("content_type_widgets.json"))
+		case "/spaces/" + spaceID + "/environments/" + environmentID + "/extensions":
+			w.WriteHeader(200)
+			_, _ = fmt.Fprintln(w, readTestData("extension.json"))
+		case "/spaces/" + spaceID + "/environments/" + environmentID + "/content_types/missing":
+			w.WriteHeader(404)
+			_, _ = fmt.Fprintln(w, readTestData("error_notfound.json"))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	editorInterface, err := editorInterfaceFromTestFile("editor_interface_layout.json")
+	assertions.Nil(err)
+
+	editorInterface.Controls = append(editorInterface.Controls, Controls{
+		FieldID:         "color",
+		WidgetNameSpace: WidgetNamespaceExtension,
+		WidgetID:        "0xvkPW9FdQ1kkWlWZ8ga4x",
+	})
+
+	err = cma.EditorInterfaces.Validate(context.Background(), env, "blogPost", editorInterface)
+	assertions.Nil(err)
+
+	editorInterface.Controls[len(editorInterface.Controls)-1].WidgetID = "unknown"
+	err = cma.EditorInterfaces.Validate(context.Background(), env, "blogPost", editorInterface)
+	assertions.NotNil(err)
+
+	// the error of the content type fetch is returned as it is
+	err = cma.EditorInterfaces.Validate(context.Background(), env, "missing", editorInterface)
+	var notFound NotFoundError
+	assertions.True(errors.As(err, &notFound))
+}
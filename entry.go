@@ -15,9 +15,10 @@ type EntriesService service
 
 // Entry model
 type Entry struct {
-	Locale string         `json:"locale"`
-	Sys    *Sys           `json:"sys"`
-	Fields map[string]any `json:"fields"`
+	Locale   string         `json:"locale"`
+	Sys      *Sys           `json:"sys"`
+	Metadata *Metadata      `json:"metadata,omitempty"`
+	Fields   map[string]any `json:"fields"`
 }
 
 // GetVersion returns entity version
@@ -47,6 +48,18 @@ func (service *EntriesService) List(ctx context.Context, env *Environment, query
 	return col, nil
 }
 
+// Iterate returns an iterator decoding the entries of all pages one by one
+func (service *EntriesService) Iterate(ctx context.Context, env *Environment, query *Query) (*Iterator[Entry], error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries", env.Sys.Space.Sys.ID, env.Sys.ID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, url.Values{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return newIterator[Entry](query, service.c, req), nil
+}
+
 // Get returns a single entry
 func (service *EntriesService) Get(ctx context.Context, env *Environment, entryID string) (*Entry, error) {
 	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", env.Sys.Space.Sys.ID, env.Sys.ID, entryID)
@@ -59,13 +72,24 @@ func (service *EntriesService) Get(ctx context.Context, env *Environment, entryI
 	}
 
 	var entry Entry
-	if ok := service.c.do(req, &entry); ok != nil {
+	if err := service.c.do(req, &entry); err != nil {
 		return nil, err
 	}
 
 	return &entry, err
 }
 
+// GetMany returns the entries with the given ids in the order of ids, fetched
+// with as few sys.id[in] requests as possible. Missing ids are reported with a
+// MissingIDsError which is returned together with the found entries.
+func (service *EntriesService) GetMany(ctx context.Context, env *Environment, ids []string) ([]Entry, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries", env.Sys.Space.Sys.ID, env.Sys.ID)
+
+	return getMany(ctx, service.c, path, ids, func(entry *Entry) string {
+		return entry.Sys.ID
+	})
+}
+
 // Delete the entry
 func (service *EntriesService) Delete(ctx context.Context, env *Environment, entryID string) error {
 	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", env.Sys.Space.Sys.ID, env.Sys.ID, entryID)
@@ -95,6 +119,21 @@ func (service *EntriesService) Publish(ctx context.Context, env *Environment, en
 	return service.c.do(req, nil)
 }
 
+// PublishIfTasksResolved publishes the entry unless it has open tasks.
+// Open tasks are returned as OpenTasksError and the entry is not published.
+func (service *EntriesService) PublishIfTasksResolved(ctx context.Context, env *Environment, entry *Entry) error {
+	open, err := service.c.EntryTasks.ListOpen(ctx, env, entry.Sys.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(open) > 0 {
+		return OpenTasksError{EntryID: entry.Sys.ID, Tasks: open}
+	}
+
+	return service.Publish(ctx, env, entry)
+}
+
 // Unpublish the entry
 func (service *EntriesService) Unpublish(ctx context.Context, env *Environment, entry *Entry) error {
 	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/published", env.Sys.Space.Sys.ID, env.Sys.ID, entry.Sys.ID)
@@ -0,0 +1,253 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// EntryCommentsService service
+type EntryCommentsService service
+
+// noinspection GoUnusedConst
+const (
+	// CommentStatusActive the comment is open
+	CommentStatusActive = "active"
+
+	// CommentStatusResolved the comment thread has been resolved
+	CommentStatusResolved = "resolved"
+)
+
+// EntryComment model
+type EntryComment struct {
+	Sys    *Sys        `json:"sys,omitempty"`
+	Body   CommentBody `json:"body"`
+	Status string      `json:"status,omitempty"`
+}
+
+// CommentBody is either plain text or a rich text document
+type CommentBody struct {
+	Text     string
+	RichText *RichTextNode
+}
+
+// RichTextNode model of a rich text document and its nodes
+type RichTextNode struct {
+	NodeType string          `json:"nodeType"`
+	Data     map[string]any  `json:"data"`
+	Content  []*RichTextNode `json:"content,omitempty"`
+	Value    string          `json:"value,omitempty"`
+	Marks    []RichTextMark  `json:"marks,omitempty"`
+}
+
+// RichTextMark model
+type RichTextMark struct {
+	Type string `json:"type"`
+}
+
+// NewRichTextDocument returns a document with a paragraph for each text
+func NewRichTextDocument(paragraphs ...string) *RichTextNode {
+	document := &RichTextNode{NodeType: "document", Data: map[string]any{}}
+	for _, paragraph := range paragraphs {
+		document.Content = append(document.Content, &RichTextNode{
+			NodeType: "paragraph",
+			Data:     map[string]any{},
+			Content: []*RichTextNode{
+				{NodeType: "text", Data: map[string]any{}, Value: paragraph, Marks: []RichTextMark{}},
+			},
+		})
+	}
+
+	return document
+}
+
+// MarshalJSON for custom json marshaling
+func (body CommentBody) MarshalJSON() ([]byte, error) {
+	if body.RichText != nil {
+		return json.Marshal(body.RichText)
+	}
+
+	return json.Marshal(body.Text)
+}
+
+// UnmarshalJSON for custom json unmarshaling
+func (body *CommentBody) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &body.Text)
+	}
+
+	var node RichTextNode
+	if err := json.Unmarshal(data, &node); err != nil {
+		return err
+	}
+	body.RichText = &node
+
+	return nil
+}
+
+// GetVersion returns entity version
+func (comment *EntryComment) GetVersion() int {
+	version := 1
+	if comment.Sys != nil {
+		version = comment.Sys.Version
+	}
+
+	return version
+}
+
+// ParentID returns the id of the comment this comment replies to
+func (comment *EntryComment) ParentID() string {
+	if comment.Sys == nil || comment.Sys.Parent == nil || comment.Sys.Parent.Sys == nil {
+		return ""
+	}
+
+	return comment.Sys.Parent.Sys.ID
+}
+
+// CommentThread is a top level comment with its replies
+type CommentThread struct {
+	Comment *EntryComment
+	Replies []*EntryComment
+}
+
+// CommentThreads groups the comments into threads, in the order of the given comments.
+// Replies to comments missing from the list start their own thread.
+func CommentThreads(comments []EntryComment) []*CommentThread {
+	threads := map[string]*CommentThread{}
+	for i := range comments {
+		comment := &comments[i]
+		if comment.ParentID() == "" && comment.Sys != nil {
+			threads[comment.Sys.ID] = &CommentThread{Comment: comment}
+		}
+	}
+
+	var ordered []*CommentThread
+	for i := range comments {
+		comment := &comments[i]
+		if thread, ok := threads[comment.ParentID()]; ok {
+			thread.Replies = append(thread.Replies, comment)
+			continue
+		}
+
+		if comment.Sys != nil && threads[comment.Sys.ID] != nil {
+			ordered = append(ordered, threads[comment.Sys.ID])
+			continue
+		}
+
+		ordered = append(ordered, &CommentThread{Comment: comment})
+	}
+
+	return ordered
+}
+
+// List returns the comments collection of an entry
+func (service *EntryCommentsService) List(ctx context.Context, env *Environment, entryID string, query *Query) (*Collection[EntryComment], error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/comments", env.Sys.Space.Sys.ID, env.Sys.ID, entryID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := newCollection[EntryComment](query, service.c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return col, nil
+}
+
+// Get returns a single comment
+func (service *EntryCommentsService) Get(ctx context.Context, env *Environment, entryID, commentID string) (*EntryComment, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/comments/%s", env.Sys.Space.Sys.ID, env.Sys.ID, entryID, commentID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var comment EntryComment
+	if err := service.c.do(req, &comment); err != nil {
+		return nil, err
+	}
+
+	return &comment, nil
+}
+
+// Create adds a new top level comment to the entry
+func (service *EntryCommentsService) Create(ctx context.Context, env *Environment, entryID string, comment *EntryComment) error {
+	return service.create(ctx, env, entryID, "", comment)
+}
+
+// Reply adds the comment as a reply to the parent comment
+func (service *EntryCommentsService) Reply(ctx context.Context, env *Environment, entryID, parentCommentID string, comment *EntryComment) error {
+	return service.create(ctx, env, entryID, parentCommentID, comment)
+}
+
+func (service *EntryCommentsService) create(ctx context.Context, env *Environment, entryID, parentCommentID string, comment *EntryComment) error {
+	bytesArray, err := json.Marshal(comment)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/comments", env.Sys.Space.Sys.ID, env.Sys.ID, entryID)
+
+	req, err := service.c.newRequest(ctx, http.MethodPost, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	setCommentBodyFormat(req, comment)
+	if parentCommentID != "" {
+		req.Header.Set("X-Contentful-Parent-Id", parentCommentID)
+	}
+
+	return service.c.do(req, comment)
+}
+
+// Update updates the body or status of the comment
+func (service *EntryCommentsService) Update(ctx context.Context, env *Environment, entryID string, comment *EntryComment) error {
+	bytesArray, err := json.Marshal(comment)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/comments/%s", env.Sys.Space.Sys.ID, env.Sys.ID, entryID, comment.Sys.ID)
+
+	req, err := service.c.newRequest(ctx, http.MethodPut, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	setCommentBodyFormat(req, comment)
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(comment.GetVersion()))
+
+	return service.c.do(req, comment)
+}
+
+// Delete the comment
+func (service *EntryCommentsService) Delete(ctx context.Context, env *Environment, entryID string, comment *EntryComment) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/comments/%s", env.Sys.Space.Sys.ID, env.Sys.ID, entryID, comment.Sys.ID)
+
+	req, err := service.c.newRequest(ctx, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(comment.GetVersion()))
+
+	return service.c.do(req, nil)
+}
+
+func setCommentBodyFormat(req *http.Request, comment *EntryComment) {
+	if comment.Body.RichText != nil {
+		req.Header.Set("X-Contentful-Comment-Body-Format", "rich-text")
+	}
+}
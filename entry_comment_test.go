@@ -0,0 +1,231 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntryCommentsService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/entries/5KsDBWseXY6QegucYAoacS/comments")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entry_comment.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	collection, err := cma.EntryComments.List(context.Background(), env, "5KsDBWseXY6QegucYAoacS", nil)
+	assertions.Nil(err)
+	comments := collection.Items
+	assertions.Equal(3, len(comments))
+	assertions.Equal("Please check the headline", comments[0].Body.Text)
+	assertions.Nil(comments[0].Body.RichText)
+	assertions.Equal("document", comments[1].Body.RichText.NodeType)
+	assertions.Equal("Fixed the headline", comments[1].Body.RichText.Content[0].Content[0].Value)
+	assertions.Equal("5KsDBWseXY6QegucYAoacS", comments[1].Sys.ParentEntity.Sys.ID)
+
+	threads := CommentThreads(comments)
+	assertions.Equal(2, len(threads))
+	assertions.Equal("comment-1", threads[0].Comment.Sys.ID)
+	assertions.Equal(1, len(threads[0].Replies))
+	assertions.Equal("comment-2", threads[0].Replies[0].Sys.ID)
+	assertions.Equal("comment-3", threads[1].Comment.Sys.ID)
+	assertions.Equal(0, len(threads[1].Replies))
+}
+
+func TestEntryCommentsService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/entries/5KsDBWseXY6QegucYAoacS/comments/comment-2")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entry_comment_rich_text.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	comment, err := cma.EntryComments.Get(context.Background(), env, "5KsDBWseXY6QegucYAoacS", "comment-2")
+	assertions.Nil(err)
+	assertions.Equal("comment-1", comment.ParentID())
+}
+
+func TestEntryCommentsService_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/entries/5KsDBWseXY6QegucYAoacS/comments")
+		assertions.Equal("", r.Header.Get("X-Contentful-Parent-Id"))
+		assertions.Equal("", r.Header.Get("X-Contentful-Comment-Body-Format"))
+
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("Please check the headline", payload["body"])
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, readTestData("entry_comment_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	comment := &EntryComment{Body: CommentBody{Text: "Please check the headline"}}
+
+	err := cma.EntryComments.Create(context.Background(), env, "5KsDBWseXY6QegucYAoacS", comment)
+	assertions.Nil(err)
+	assertions.Equal("comment-1", comment.Sys.ID)
+}
+
+func TestEntryCommentsService_Reply(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/entries/5KsDBWseXY6QegucYAoacS/comments")
+		assertions.Equal("comment-1", r.Header.Get("X-Contentful-Parent-Id"))
+		assertions.Equal("rich-text", r.Header.Get("X-Contentful-Comment-Body-Format"))
+
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		body := payload["body"].(map[string]interface{})
+		assertions.Equal("document", body["nodeType"])
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, readTestData("entry_comment_rich_text.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	comment := &EntryComment{Body: CommentBody{RichText: NewRichTextDocument("Fixed the headline")}}
+
+	err := cma.EntryComments.Reply(context.Background(), env, "5KsDBWseXY6QegucYAoacS", "comment-1", comment)
+	assertions.Nil(err)
+	assertions.Equal("comment-2", comment.Sys.ID)
+	assertions.Equal("comment-1", comment.ParentID())
+}
+
+func TestEntryCommentsService_Update(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "PUT")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/entries/5KsDBWseXY6QegucYAoacS/comments/comment-1")
+		assertions.Equal("1", r.Header.Get("X-Contentful-Version"))
+
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("resolved", payload["status"])
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entry_comment_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	comment := &EntryComment{
+		Sys:    &Sys{ID: "comment-1", Version: 1},
+		Body:   CommentBody{Text: "Please check the headline"},
+		Status: CommentStatusResolved,
+	}
+
+	err := cma.EntryComments.Update(context.Background(), env, "5KsDBWseXY6QegucYAoacS", comment)
+	assertions.Nil(err)
+}
+
+func TestEntryCommentsService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "DELETE")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/entries/5KsDBWseXY6QegucYAoacS/comments/comment-1")
+		assertions.Equal("1", r.Header.Get("X-Contentful-Version"))
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(204)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	err := cma.EntryComments.Delete(context.Background(), env, "5KsDBWseXY6QegucYAoacS", &EntryComment{Sys: &Sys{ID: "comment-1", Version: 1}})
+	assertions.Nil(err)
+}
+
+func TestCommentBody_UnmarshalJSON(t *testing.T) {
+	assertions := assert.New(t)
+
+	var comment EntryComment
+	err := json.Unmarshal([]byte(`{"body": null}`), &comment)
+	assertions.Nil(err)
+	assertions.Nil(comment.Body.RichText)
+	assertions.Equal("", comment.Body.Text)
+
+	err = json.Unmarshal([]byte(`{"body": "Please check the headline"}`), &comment)
+	assertions.Nil(err)
+	assertions.Nil(comment.Body.RichText)
+	assertions.Equal("Please check the headline", comment.Body.Text)
+
+	comment = EntryComment{}
+	err = json.Unmarshal([]byte(`{"body": {"nodeType": "document", "data": {}, "content": []}}`), &comment)
+	assertions.Nil(err)
+	assertions.Equal("document", comment.Body.RichText.NodeType)
+}
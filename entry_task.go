@@ -8,17 +8,30 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 )
 
 // EntryTasksService service
 type EntryTasksService service
 
+// noinspection GoUnusedConst
+const (
+	// EntryTaskStatusActive the task still has to be done
+	EntryTaskStatusActive = "active"
+
+	// EntryTaskStatusResolved the task has been done
+	EntryTaskStatusResolved = "resolved"
+)
+
 // EntryTask model
 type EntryTask struct {
 	Sys        *Sys       `json:"sys"`
 	Body       string     `json:"body"`
 	Status     string     `json:"status"`
 	AssignedTo AssignedTo `json:"assignedTo"`
+
+	// DueDate is an optional ISO 8601 date like "2021-06-30"
+	DueDate string `json:"dueDate,omitempty"`
 }
 
 // AssignedTo model
@@ -36,6 +49,34 @@ func (entryTask *EntryTask) GetVersion() int {
 	return version
 }
 
+// IsOpen reports whether the task is not resolved yet
+func (entryTask *EntryTask) IsOpen() bool {
+	return entryTask.Status != EntryTaskStatusResolved
+}
+
+// ResolvedAt returns when the task was resolved, false if it is open
+func (entryTask *EntryTask) ResolvedAt() (time.Time, bool) {
+	if entryTask.Sys == nil || entryTask.Sys.ResolvedAt == "" {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339, entryTask.Sys.ResolvedAt)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
+
+// ResolvedBy returns the id of the user who resolved the task
+func (entryTask *EntryTask) ResolvedBy() string {
+	if entryTask.Sys == nil || entryTask.Sys.ResolvedBy == nil || entryTask.Sys.ResolvedBy.Sys == nil {
+		return ""
+	}
+
+	return entryTask.Sys.ResolvedBy.Sys.ID
+}
+
 // List returns entry tasks collection
 func (service *EntryTasksService) List(ctx context.Context, env *Environment, entryID string, query *Query) (*Collection[EntryTask], error) {
 	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/tasks", env.Sys.Space.Sys.ID, env.Sys.ID, entryID)
@@ -65,7 +106,7 @@ func (service *EntryTasksService) Get(ctx context.Context, env *Environment, ent
 	}
 
 	var entryTask EntryTask
-	if ok := service.c.do(req, &entryTask); ok != nil {
+	if err := service.c.do(req, &entryTask); err != nil {
 		return nil, err
 	}
 
@@ -112,3 +153,31 @@ func (service *EntryTasksService) Upsert(ctx context.Context, env *Environment,
 
 	return service.c.do(req, entryTask)
 }
+
+// Resolve marks the entry task as resolved
+func (service *EntryTasksService) Resolve(ctx context.Context, env *Environment, entryID string, entryTask *EntryTask) error {
+	entryTask.Status = EntryTaskStatusResolved
+	return service.Upsert(ctx, env, entryID, entryTask)
+}
+
+// ListOpen returns all tasks of the entry which are not resolved yet
+func (service *EntryTasksService) ListOpen(ctx context.Context, env *Environment, entryID string) ([]EntryTask, error) {
+	col, err := service.List(ctx, env, entryID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	entryTasks, err := col.collectAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var open []EntryTask
+	for i := range entryTasks {
+		if entryTasks[i].IsOpen() {
+			open = append(open, entryTasks[i])
+		}
+	}
+
+	return open, nil
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -90,7 +91,7 @@ func TestEntryTasksService_Get_2(t *testing.T) {
 	cma.BaseURL = server.URL
 
 	_, err = cma.EntryTasks.Get(context.Background(), env, "5KsDBWseXY6QegucYAoacS", "RHfHVRz3QkAgcMq4CGg2m5")
-	assertions.Nil(err)
+	assertions.NotNil(err)
 }
 
 func TestEntryTasksService_Delete(t *testing.T) {
@@ -203,3 +204,72 @@ func TestEntryTasksService_Upsert_Update(t *testing.T) {
 	assertions.Equal("Review translation", entryTask.Body)
 	assertions.Equal("active", entryTask.Status)
 }
+
+func TestEntryTasksService_Resolve(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "PUT")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/entries/5KsDBWseXY6QegucYAoacS/tasks/RHfHVRz3QkAgcMq4CGg2m5")
+		assertions.Equal("1", r.Header.Get("X-Contentful-Version"))
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("resolved", payload["status"])
+		assertions.Equal("2021-06-30", payload["dueDate"])
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entry_task_resolved.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	entryTask, err := entryTaskFromTestFile("entry_task_1.json")
+	assertions.Nil(err)
+	assertions.True(entryTask.IsOpen())
+	entryTask.DueDate = "2021-06-30"
+
+	err = cma.EntryTasks.Resolve(context.Background(), env, "5KsDBWseXY6QegucYAoacS", entryTask)
+	assertions.Nil(err)
+	assertions.False(entryTask.IsOpen())
+	assertions.Equal("4FLrUHftHW3v2BLi9fzfjU", entryTask.ResolvedBy())
+
+	resolvedAt, ok := entryTask.ResolvedAt()
+	assertions.True(ok)
+	assertions.Equal(time.Date(2021, 6, 15, 9, 30, 0, 0, time.UTC), resolvedAt)
+}
+
+func TestEntryTasksService_ListOpen(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/entries/5KsDBWseXY6QegucYAoacS/tasks")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintf(w, `{"total": 2, "items": [%s, %s]}`, readTestData("entry_task_1.json"), readTestData("entry_task_resolved.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	open, err := cma.EntryTasks.ListOpen(context.Background(), env, "5KsDBWseXY6QegucYAoacS")
+	assertions.Nil(err)
+	assertions.Equal(1, len(open))
+	assertions.Equal("Review translation", open[0].Body)
+}
@@ -3,6 +3,7 @@ package contentful
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -89,7 +90,7 @@ func TestEntriesService_Get_2(t *testing.T) {
 	cma.BaseURL = server.URL
 
 	_, err = cma.Entries.Get(context.Background(), env, "5KsDBWseXY6QegucYAoacS")
-	assertions.Nil(err)
+	assertions.NotNil(err)
 }
 
 func TestEntriesService_Delete(t *testing.T) {
@@ -241,6 +242,74 @@ func TestEntriesService_Publish(t *testing.T) {
 	assertions.Nil(err)
 }
 
+func TestEntriesService_PublishIfTasksResolved(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	published := false
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkHeaders(r, assertions)
+
+		switch r.URL.Path {
+		case "/spaces/" + spaceID + "/environments/" + environmentID + "/entries/5KsDBWseXY6QegucYAoacS/tasks":
+			w.WriteHeader(200)
+			_, _ = fmt.Fprintf(w, `{"total": 1, "items": [%s]}`, readTestData("entry_task_resolved.json"))
+		case "/spaces/" + spaceID + "/environments/" + environmentID + "/entries/5KsDBWseXY6QegucYAoacS/published":
+			published = true
+			w.WriteHeader(200)
+			_, _ = fmt.Fprintln(w, readTestData("entry_1.json"))
+		}
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	e, err := entryFromTestData("entry_1.json")
+	assertions.Nil(err)
+
+	err = cma.Entries.PublishIfTasksResolved(context.Background(), env, e)
+	assertions.Nil(err)
+	assertions.True(published)
+}
+
+func TestEntriesService_PublishIfTasksResolved_OpenTasks(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/entries/5KsDBWseXY6QegucYAoacS/tasks")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entry_task.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	e, err := entryFromTestData("entry_1.json")
+	assertions.Nil(err)
+
+	err = cma.Entries.PublishIfTasksResolved(context.Background(), env, e)
+
+	var openTasks OpenTasksError
+	assertions.True(errors.As(err, &openTasks))
+	assertions.Equal("5KsDBWseXY6QegucYAoacS", openTasks.EntryID)
+	assertions.Equal(1, len(openTasks.Tasks))
+	assertions.Equal("entry 5KsDBWseXY6QegucYAoacS has 1 open tasks", err.Error())
+}
+
 func TestEntriesService_Unpublish(t *testing.T) {
 	var err error
 	assertions := assert.New(t)
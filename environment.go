@@ -11,6 +11,18 @@ import (
 // EnvironmentsService service
 type EnvironmentsService service
 
+// noinspection GoUnusedConst
+const (
+	// EnvironmentStatusQueued the environment is being created
+	EnvironmentStatusQueued SysStatus = "queued"
+
+	// EnvironmentStatusReady the environment can be used
+	EnvironmentStatusReady SysStatus = "ready"
+
+	// EnvironmentStatusFailed the environment could not be created
+	EnvironmentStatusFailed SysStatus = "failed"
+)
+
 // Environment model
 type Environment struct {
 	Sys  *Sys   `json:"sys"`
@@ -63,6 +75,15 @@ func (service *EnvironmentsService) Get(ctx context.Context, spaceID, environmen
 	return &environment, nil
 }
 
+// Status returns the environment status
+func (e *Environment) Status() SysStatus {
+	if e.Sys == nil {
+		return ""
+	}
+
+	return e.Sys.Status
+}
+
 // Upsert updates or creates a new environment
 func (service *EnvironmentsService) Upsert(ctx context.Context, spaceID string, e *Environment) error {
 	bytesArray, err := json.Marshal(e)
@@ -106,3 +127,60 @@ func (service *EnvironmentsService) Delete(ctx context.Context, spaceID string,
 
 	return service.c.do(req, nil)
 }
+
+// CreateFrom creates a new environment as a clone of the source environment.
+// Cloning is asynchronous, use WaitUntilReady to wait for the new environment.
+func (service *EnvironmentsService) CreateFrom(ctx context.Context, spaceID, environmentID, sourceEnvironmentID string) (*Environment, error) {
+	bytesArray, err := json.Marshal(&Environment{Name: environmentID})
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/spaces/%s/environments/%s", spaceID, environmentID)
+	method := "PUT"
+
+	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-Contentful-Source-Environment", sourceEnvironmentID)
+
+	var environment Environment
+	if err := service.c.do(req, &environment); err != nil {
+		return nil, err
+	}
+
+	return &environment, nil
+}
+
+// WaitUntilReady polls the environment until its status is ready.
+// If backoff is nil, DefaultBackoff is used.
+func (service *EnvironmentsService) WaitUntilReady(ctx context.Context, spaceID, environmentID string, backoff *Backoff) (*Environment, error) {
+	if backoff == nil {
+		backoff = &DefaultBackoff
+	}
+
+	var environment *Environment
+	err := backoff.poll(ctx, func() (bool, error) {
+		var err error
+		environment, err = service.Get(ctx, spaceID, environmentID)
+		if err != nil {
+			return false, err
+		}
+
+		switch environment.Status() {
+		case EnvironmentStatusReady:
+			return true, nil
+		case EnvironmentStatusFailed:
+			return false, EnvironmentFailedError{Environment: environment}
+		default:
+			return false, nil
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return environment, nil
+}
@@ -0,0 +1,253 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+const promotionTimeFormat = "20060102-150405"
+
+// discardTimeout limits the deletion of a cloned environment after the promotion context is done
+const discardTimeout = 30 * time.Second
+
+var promotionSuffixRegex = regexp.MustCompile(`^\d{8}-\d{6}$`)
+
+// PromotionOptions configures EnvironmentAliasesService.Promote
+type PromotionOptions struct {
+	// Migrate runs against the cloned environment before the alias is switched
+	Migrate func(ctx context.Context, env *Environment) error
+
+	// Validate optionally checks the content of the cloned environment before the alias is switched
+	Validate func(ctx context.Context, env *Environment) error
+
+	// Keep is the number of previous environments kept for rollback, older ones are deleted.
+	// Zero keeps all of them.
+	Keep int
+
+	// Backoff used while waiting for the cloned environment, DefaultBackoff if nil
+	Backoff *Backoff
+}
+
+// Promotion is the result of EnvironmentAliasesService.Promote
+type Promotion struct {
+	Alias       *EnvironmentAlias
+	Environment *Environment
+	Previous    string
+	Deleted     []string
+}
+
+// Promote clones the environment the alias points to into a new timestamped
+// environment, runs the migration and validation against it and switches the alias.
+// The alias update uses its version, so a concurrent change of the alias fails the promotion.
+// If the cloned environment does not become ready or the migration or validation
+// fails, the cloned environment is deleted again.
+func (service *EnvironmentAliasesService) Promote(ctx context.Context, spaceID, aliasID string, opts PromotionOptions) (*Promotion, error) {
+	alias, err := service.Get(ctx, spaceID, aliasID)
+	if err != nil {
+		return nil, err
+	}
+
+	if alias.Alias == nil || alias.Alias.Sys == nil {
+		return nil, fmt.Errorf("environment alias %s does not point to an environment", aliasID)
+	}
+
+	previous := alias.Alias.Sys.ID
+	environmentID := aliasID + "-" + time.Now().UTC().Format(promotionTimeFormat)
+
+	created, err := service.c.Environments.CreateFrom(ctx, spaceID, environmentID, previous)
+	if err != nil {
+		return nil, err
+	}
+
+	env, err := service.c.Environments.WaitUntilReady(ctx, spaceID, environmentID, opts.Backoff)
+	if err != nil {
+		service.discard(ctx, spaceID, created)
+		return nil, err
+	}
+
+	if env.Sys.Space == nil || env.Sys.Space.Sys == nil {
+		env.Sys.Space = &Space{Sys: &Sys{ID: spaceID}}
+	}
+
+	if opts.Migrate != nil {
+		if err := opts.Migrate(ctx, env); err != nil {
+			service.discard(ctx, spaceID, env)
+			return nil, fmt.Errorf("migration of environment %s failed: %w", environmentID, err)
+		}
+	}
+
+	if opts.Validate != nil {
+		if err := opts.Validate(ctx, env); err != nil {
+			service.discard(ctx, spaceID, env)
+			return nil, fmt.Errorf("validation of environment %s failed: %w", environmentID, err)
+		}
+	}
+
+	alias.Alias.Sys.ID = environmentID
+	if err := service.Update(ctx, spaceID, alias); err != nil {
+		service.discard(ctx, spaceID, env)
+		return nil, err
+	}
+
+	promotion := &Promotion{
+		Alias:       alias,
+		Environment: env,
+		Previous:    previous,
+	}
+
+	if opts.Keep > 0 {
+		promotion.Deleted, err = service.prune(ctx, spaceID, aliasID, opts.Keep)
+		if err != nil {
+			return promotion, err
+		}
+	}
+
+	return promotion, nil
+}
+
+// Rollback points the alias to the given environment. If environmentID is empty,
+// the most recent promoted environment older than the current one is used, which
+// requires the alias to point to a promoted environment.
+func (service *EnvironmentAliasesService) Rollback(ctx context.Context, spaceID, aliasID, environmentID string) (*EnvironmentAlias, error) {
+	alias, err := service.Get(ctx, spaceID, aliasID)
+	if err != nil {
+		return nil, err
+	}
+
+	if alias.Alias == nil || alias.Alias.Sys == nil {
+		return nil, fmt.Errorf("environment alias %s does not point to an environment", aliasID)
+	}
+
+	current := alias.Alias.Sys.ID
+	if environmentID == "" {
+		if !isPromotedEnvironment(aliasID, current) {
+			return nil, fmt.Errorf("environment alias %s points to %s, which was not created by a promotion", aliasID, current)
+		}
+
+		promoted, err := service.promotedEnvironments(ctx, spaceID, aliasID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range promoted {
+			if id < current {
+				environmentID = id
+				break
+			}
+		}
+
+		if environmentID == "" {
+			return nil, fmt.Errorf("no environment to roll back environment alias %s to", aliasID)
+		}
+	}
+
+	env, err := service.c.Environments.Get(ctx, spaceID, environmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if env.Status() != EnvironmentStatusReady {
+		return nil, fmt.Errorf("environment %s is not ready", environmentID)
+	}
+
+	alias.Alias.Sys.ID = environmentID
+	if err := service.Update(ctx, spaceID, alias); err != nil {
+		return nil, err
+	}
+
+	return alias, nil
+}
+
+// discard deletes an environment created by a failed promotion.
+// Errors are ignored as the promotion error is more relevant to the caller.
+// A canceled or expired ctx may be the cause of the failure, the delete then
+// runs with a fresh context limited to discardTimeout.
+func (service *EnvironmentAliasesService) discard(ctx context.Context, spaceID string, env *Environment) {
+	if ctx.Err() != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), discardTimeout)
+		defer cancel()
+	}
+
+	_ = service.c.Environments.Delete(ctx, spaceID, env)
+}
+
+// prune deletes the promoted environments of the alias, keeping the newest ones
+// and every environment an alias points to
+func (service *EnvironmentAliasesService) prune(ctx context.Context, spaceID, aliasID string, keep int) ([]string, error) {
+	aliases, err := service.List(ctx, spaceID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	inUse := map[string]bool{}
+	for _, alias := range aliases.Items {
+		if alias.Alias != nil && alias.Alias.Sys != nil {
+			inUse[alias.Alias.Sys.ID] = true
+		}
+	}
+
+	promoted, err := service.promotedEnvironments(ctx, spaceID, aliasID)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []string
+	for _, id := range promoted {
+		if !inUse[id] {
+			candidates = append(candidates, id)
+		}
+	}
+
+	if len(candidates) <= keep {
+		return nil, nil
+	}
+
+	var deleted []string
+	for _, id := range candidates[keep:] {
+		env, err := service.c.Environments.Get(ctx, spaceID, id)
+		if err != nil {
+			return deleted, err
+		}
+
+		if err := service.c.Environments.Delete(ctx, spaceID, env); err != nil {
+			return deleted, err
+		}
+
+		deleted = append(deleted, id)
+	}
+
+	return deleted, nil
+}
+
+// promotedEnvironments returns the ids of the environments created by promotions of the alias, newest first
+func (service *EnvironmentAliasesService) promotedEnvironments(ctx context.Context, spaceID, aliasID string) ([]string, error) {
+	environments, err := service.c.Environments.List(ctx, spaceID, NewQuery().Limit(1000))
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, env := range environments.Items {
+		if env.Sys != nil && isPromotedEnvironment(aliasID, env.Sys.ID) {
+			ids = append(ids, env.Sys.ID)
+		}
+	}
+
+	sort.Sort(sort.Reverse(sort.StringSlice(ids)))
+
+	return ids, nil
+}
+
+func isPromotedEnvironment(aliasID, environmentID string) bool {
+	prefix := aliasID + "-"
+	if !strings.HasPrefix(environmentID, prefix) {
+		return false
+	}
+
+	return promotionSuffixRegex.MatchString(strings.TrimPrefix(environmentID, prefix))
+}
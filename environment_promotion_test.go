@@ -0,0 +1,235 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type promotionServer struct {
+	mu           sync.Mutex
+	current      string
+	environments map[string]bool
+	created      string
+	deleted      []string
+	aliasUpdates int
+
+	// pending keeps created environments queued
+	pending bool
+}
+
+func newPromotionServer(current string, environments ...string) *promotionServer {
+	s := &promotionServer{current: current, environments: map[string]bool{}}
+	for _, id := range environments {
+		s.environments[id] = true
+	}
+
+	return s
+}
+
+func (s *promotionServer) alias() *EnvironmentAlias {
+	return &EnvironmentAlias{
+		Sys:   &Sys{ID: "master", Type: "EnvironmentAlias", Version: 1 + s.aliasUpdates},
+		Alias: &AliasDetail{Sys: &Sys{ID: s.current, Type: "Link", LinkType: "Environment"}},
+	}
+}
+
+func (s *promotionServer) environment(id string, status SysStatus) *Environment {
+	return &Environment{
+		Name: id,
+		Sys: &Sys{
+			ID:      id,
+			Type:    "Environment",
+			Version: 1,
+			Status:  status,
+			Space:   &Space{Sys: &Sys{ID: spaceID}},
+		},
+	}
+}
+
+func (s *promotionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	envPrefix := "/spaces/" + spaceID + "/environments/"
+	var body interface{}
+
+	switch {
+	case r.URL.Path == "/spaces/"+spaceID+"/environment_aliases/master" && r.Method == "GET":
+		body = s.alias()
+	case r.URL.Path == "/spaces/"+spaceID+"/environment_aliases/master" && r.Method == "PUT":
+		var alias EnvironmentAlias
+		_ = json.NewDecoder(r.Body).Decode(&alias)
+		s.current = alias.Alias.Sys.ID
+		s.aliasUpdates++
+		body = s.alias()
+	case r.URL.Path == "/spaces/"+spaceID+"/environment_aliases":
+		body = map[string]interface{}{"total": 1, "items": []*EnvironmentAlias{s.alias()}}
+	case r.URL.Path == "/spaces/"+spaceID+"/environments":
+		var items []*Environment
+		for id := range s.environments {
+			items = append(items, s.environment(id, EnvironmentStatusReady))
+		}
+		sort.Slice(items, func(i, j int) bool { return items[i].Sys.ID < items[j].Sys.ID })
+		body = map[string]interface{}{"total": len(items), "items": items}
+	case strings.HasPrefix(r.URL.Path, envPrefix):
+		id := strings.TrimPrefix(r.URL.Path, envPrefix)
+		switch r.Method {
+		case "PUT":
+			s.created = id
+			s.environments[id] = true
+			w.WriteHeader(201)
+			body = s.environment(id, EnvironmentStatusQueued)
+		case "DELETE":
+			delete(s.environments, id)
+			s.deleted = append(s.deleted, id)
+			w.WriteHeader(204)
+			return
+		default:
+			status := EnvironmentStatusReady
+			if s.pending && id == s.created {
+				status = EnvironmentStatusQueued
+			}
+			body = s.environment(id, status)
+		}
+	default:
+		w.WriteHeader(404)
+		_, _ = w.Write([]byte(`{"sys":{"id":"NotFound"}}`))
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestEnvironmentAliasesService_Promote(t *testing.T) {
+	assertions := assert.New(t)
+
+	fake := newPromotionServer("master-20200101-000000", "master-20180101-000000", "master-20190101-000000", "master-20200101-000000", "sandbox")
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	var migrated *Environment
+	promotion, err := cma.EnvironmentAliases.Promote(context.Background(), spaceID, "master", PromotionOptions{
+		Migrate: func(ctx context.Context, env *Environment) error {
+			migrated = env
+			return nil
+		},
+		Validate: func(ctx context.Context, env *Environment) error {
+			return nil
+		},
+		Keep:    1,
+		Backoff: &Backoff{Initial: time.Millisecond},
+	})
+	assertions.Nil(err)
+
+	assertions.True(strings.HasPrefix(fake.created, "master-"))
+	assertions.Equal(fake.created, migrated.Sys.ID)
+	assertions.Equal(spaceID, migrated.Sys.Space.Sys.ID)
+	assertions.Equal(fake.created, fake.current)
+	assertions.Equal(fake.created, promotion.Environment.Sys.ID)
+	assertions.Equal("master-20200101-000000", promotion.Previous)
+	assertions.Equal([]string{"master-20190101-000000", "master-20180101-000000"}, promotion.Deleted)
+	assertions.True(fake.environments["master-20200101-000000"])
+	assertions.True(fake.environments["sandbox"])
+}
+
+func TestEnvironmentAliasesService_Promote_MigrationFailed(t *testing.T) {
+	assertions := assert.New(t)
+
+	fake := newPromotionServer("master-20200101-000000", "master-20200101-000000")
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	migrationErr := errors.New("migration failed")
+	_, err := cma.EnvironmentAliases.Promote(context.Background(), spaceID, "master", PromotionOptions{
+		Migrate: func(ctx context.Context, env *Environment) error {
+			return migrationErr
+		},
+		Backoff: &Backoff{Initial: time.Millisecond},
+	})
+	assertions.True(errors.Is(err, migrationErr))
+	assertions.Equal("master-20200101-000000", fake.current)
+	assertions.Equal([]string{fake.created}, fake.deleted)
+	assertions.Equal(0, fake.aliasUpdates)
+}
+
+func TestEnvironmentAliasesService_Promote_NotReady(t *testing.T) {
+	assertions := assert.New(t)
+
+	fake := newPromotionServer("master-20200101-000000", "master-20200101-000000")
+	fake.pending = true
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := cma.EnvironmentAliases.Promote(ctx, spaceID, "master", PromotionOptions{
+		Backoff: &Backoff{Initial: time.Millisecond},
+	})
+	assertions.True(errors.Is(err, context.DeadlineExceeded))
+	assertions.Equal("master-20200101-000000", fake.current)
+	assertions.Equal([]string{fake.created}, fake.deleted)
+	assertions.Equal(0, fake.aliasUpdates)
+}
+
+func TestEnvironmentAliasesService_Rollback(t *testing.T) {
+	assertions := assert.New(t)
+
+	fake := newPromotionServer("master-20200101-000000", "master-20180101-000000", "master-20190101-000000", "master-20200101-000000", "master-20210101-000000")
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	alias, err := cma.EnvironmentAliases.Rollback(context.Background(), spaceID, "master", "")
+	assertions.Nil(err)
+	assertions.Equal("master-20190101-000000", alias.Alias.Sys.ID)
+	assertions.Equal("master-20190101-000000", fake.current)
+
+	alias, err = cma.EnvironmentAliases.Rollback(context.Background(), spaceID, "master", "master-20210101-000000")
+	assertions.Nil(err)
+	assertions.Equal("master-20210101-000000", alias.Alias.Sys.ID)
+
+	fake = newPromotionServer("master-20200101-000000", "master-20200101-000000")
+	server2 := httptest.NewServer(fake)
+	defer server2.Close()
+	cma.BaseURL = server2.URL
+
+	_, err = cma.EnvironmentAliases.Rollback(context.Background(), spaceID, "master", "")
+	assertions.NotNil(err)
+
+	// the alias points to an environment which was not promoted
+	fake = newPromotionServer("sandbox", "master-20190101-000000", "master-20200101-000000", "sandbox")
+	server3 := httptest.NewServer(fake)
+	defer server3.Close()
+	cma.BaseURL = server3.URL
+
+	_, err = cma.EnvironmentAliases.Rollback(context.Background(), spaceID, "master", "")
+	assertions.NotNil(err)
+	assertions.Equal("sandbox", fake.current)
+	assertions.Equal(0, fake.aliasUpdates)
+}
@@ -3,10 +3,12 @@ package contentful
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -65,6 +67,7 @@ func TestEnvironmentsService_Get(t *testing.T) {
 	environment, err := cma.Environments.Get(context.Background(), spaceID, "staging")
 	assertions.Nil(err)
 	assertions.Equal("staging", environment.Name)
+	assertions.Equal(EnvironmentStatusReady, environment.Status())
 }
 
 func TestEnvironmentsService_Get_2(t *testing.T) {
@@ -193,3 +196,116 @@ func TestEnvironmentsService_Delete(t *testing.T) {
 	err = cma.Environments.Delete(context.Background(), spaceID, environment)
 	assertions.Nil(err)
 }
+
+func TestEnvironmentsService_CreateFrom(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "PUT")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/staging")
+		assertions.Equal("master", r.Header.Get("X-Contentful-Source-Environment"))
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("staging", payload["name"])
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, readTestData("environment_queued.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	environment, err := cma.Environments.CreateFrom(context.Background(), spaceID, "staging", "master")
+	assertions.Nil(err)
+	assertions.Equal("staging", environment.Sys.ID)
+	assertions.Equal(EnvironmentStatusQueued, environment.Status())
+}
+
+func TestEnvironmentsService_WaitUntilReady(t *testing.T) {
+	assertions := assert.New(t)
+
+	calls := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/staging")
+		checkHeaders(r, assertions)
+
+		calls++
+		w.WriteHeader(200)
+		if calls < 3 {
+			_, _ = fmt.Fprintln(w, readTestData("environment_queued.json"))
+			return
+		}
+		_, _ = fmt.Fprintln(w, readTestData("environment_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	environment, err := cma.Environments.WaitUntilReady(context.Background(), spaceID, "staging", &Backoff{Initial: time.Millisecond})
+	assertions.Nil(err)
+	assertions.Equal(3, calls)
+	assertions.Equal(EnvironmentStatusReady, environment.Status())
+}
+
+func TestEnvironmentsService_WaitUntilReady_Failed(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("environment_failed.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	_, err := cma.Environments.WaitUntilReady(context.Background(), spaceID, "staging", &Backoff{Initial: time.Millisecond})
+	var failedErr EnvironmentFailedError
+	assertions.True(errors.As(err, &failedErr))
+	assertions.Equal("staging", failedErr.Environment.Sys.ID)
+	assertions.Equal("environment staging failed to be created", err.Error())
+
+	assertions.Equal("environment failed to be created", EnvironmentFailedError{}.Error())
+	assertions.Equal("environment failed to be created", EnvironmentFailedError{Environment: &Environment{}}.Error())
+}
+
+func TestEnvironmentsService_WaitUntilReady_Timeout(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("environment_queued.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := cma.Environments.WaitUntilReady(ctx, spaceID, "staging", &Backoff{Initial: time.Millisecond, Max: 5 * time.Millisecond, Multiplier: 2})
+	assertions.True(errors.Is(err, context.DeadlineExceeded))
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 // ErrorResponse model
@@ -100,6 +101,115 @@ func (e RateLimitExceededError) Error() string {
 	return e.APIError.err.Message
 }
 
+// EnvironmentFailedError is returned when an environment could not be created
+type EnvironmentFailedError struct {
+	Environment *Environment
+}
+
+func (e EnvironmentFailedError) Error() string {
+	if e.Environment == nil || e.Environment.Sys == nil || e.Environment.Sys.ID == "" {
+		return "environment failed to be created"
+	}
+
+	return "environment " + e.Environment.Sys.ID + " failed to be created"
+}
+
+// ReleaseActionFailedError is returned when a release action failed
+type ReleaseActionFailedError struct {
+	Action *ReleaseAction
+}
+
+func (e ReleaseActionFailedError) Error() string {
+	msg := "release action " + e.Action.Sys.ID + " (" + e.Action.Action + ") failed"
+	if e.Action.Error != nil && e.Action.Error.Message != "" {
+		msg += ": " + e.Action.Error.Message
+	}
+
+	return msg
+}
+
+// OpenTasksError is returned when an entry with open tasks should be published
+type OpenTasksError struct {
+	EntryID string
+	Tasks   []EntryTask
+}
+
+func (e OpenTasksError) Error() string {
+	return fmt.Sprintf("entry %s has %d open tasks", e.EntryID, len(e.Tasks))
+}
+
+// AppRequestVerificationError is returned when a signed app request can not be verified
+type AppRequestVerificationError struct {
+	Reason string
+}
+
+func (e AppRequestVerificationError) Error() string {
+	return "app request verification failed: " + e.Reason
+}
+
+// EditorInterfaceValidationError is returned when an editor interface does not match its content type
+type EditorInterfaceValidationError struct {
+	Problems []EditorInterfaceProblem
+}
+
+func (e EditorInterfaceValidationError) Error() string {
+	msg := fmt.Sprintf("editor interface has %d problems", len(e.Problems))
+	for _, problem := range e.Problems {
+		msg += "; " + problem.String()
+	}
+
+	return msg
+}
+
+// QueryBuildError is returned when a typed query is invalid for its content type
+type QueryBuildError struct {
+	Field    string
+	Operator string
+	Reason   string
+}
+
+func (e QueryBuildError) Error() string {
+	target := e.Field
+	if e.Operator != "" {
+		target += "[" + e.Operator + "]"
+	}
+
+	if target == "" {
+		return "invalid query: " + e.Reason
+	}
+
+	return "invalid query " + target + ": " + e.Reason
+}
+
+// UnsupportedQueryParamsError is returned by ParseQuery for parameters a query can not express
+type UnsupportedQueryParamsError struct {
+	Params []string
+}
+
+func (e UnsupportedQueryParamsError) Error() string {
+	return "unsupported query parameters: " + strings.Join(e.Params, ", ")
+}
+
+// AccessStateError is returned by PlanAccess for users of the desired state
+// which would be members of a space without any role
+type AccessStateError struct {
+	// Grants are the "email in space" pairs without roles
+	Grants []string
+}
+
+func (e AccessStateError) Error() string {
+	return "desired access without roles: " + strings.Join(e.Grants, ", ")
+}
+
+// MissingIDsError is returned by GetMany for ids without an item, the found items are returned with it
+type MissingIDsError struct {
+	IDs []string
+}
+
+func (e MissingIDsError) Error() string {
+	return "items not found: " + strings.Join(e.IDs, ", ")
+}
+
 // BadRequestError error model for bad request responses
 type BadRequestError struct{}
 
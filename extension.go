@@ -28,7 +28,9 @@ type ExtensionDetails struct {
 
 // FieldTypes model
 type FieldTypes struct {
-	Type string `json:"type"`
+	Type     string      `json:"type"`
+	LinkType string      `json:"linkType,omitempty"`
+	Items    *FieldTypes `json:"items,omitempty"`
 }
 
 // GetVersion returns entity version
@@ -70,7 +72,7 @@ func (service *ExtensionsService) Get(ctx context.Context, env *Environment, ext
 	}
 
 	var extension Extension
-	if ok := service.c.do(req, &extension); ok != nil {
+	if err := service.c.do(req, &extension); err != nil {
 		return nil, err
 	}
 
@@ -92,7 +92,7 @@ func TestExtensionsService_Get_2(t *testing.T) {
 	cma.BaseURL = server.URL
 
 	_, err = cma.Extensions.Get(context.Background(), env, "0xvkPW9FdQ1kkWlWZ8ga4x")
-	assertions.Nil(err)
+	assertions.NotNil(err)
 }
 
 func TestExtensionsService_Upsert_Create(t *testing.T) {
@@ -0,0 +1,367 @@
+package contentful
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ImageFit resizing behaviour of the Images API
+type ImageFit string
+
+// noinspection GoUnusedConst
+const (
+	// ImageFitPad resizes the image to the specified dimensions, padding it if needed
+	ImageFitPad ImageFit = "pad"
+
+	// ImageFitFill resizes the image to the specified dimensions, cropping it if needed
+	ImageFitFill ImageFit = "fill"
+
+	// ImageFitScale resizes the image to the specified dimensions, changing the original aspect ratio if needed
+	ImageFitScale ImageFit = "scale"
+
+	// ImageFitCrop crops a part of the original image to fit into the specified dimensions
+	ImageFitCrop ImageFit = "crop"
+
+	// ImageFitThumb creates a thumbnail from the image, focusing on the given focus area
+	ImageFitThumb ImageFit = "thumb"
+)
+
+// ImageFocus focus area used when cropping or filling an image
+type ImageFocus string
+
+// noinspection GoUnusedConst
+const (
+	ImageFocusCenter      ImageFocus = "center"
+	ImageFocusTop         ImageFocus = "top"
+	ImageFocusRight       ImageFocus = "right"
+	ImageFocusLeft        ImageFocus = "left"
+	ImageFocusBottom      ImageFocus = "bottom"
+	ImageFocusTopRight    ImageFocus = "top_right"
+	ImageFocusTopLeft     ImageFocus = "top_left"
+	ImageFocusBottomRight ImageFocus = "bottom_right"
+	ImageFocusBottomLeft  ImageFocus = "bottom_left"
+
+	// ImageFocusFace focuses on the largest face detected in the image
+	ImageFocusFace ImageFocus = "face"
+
+	// ImageFocusFaces focuses on the area containing all faces detected in the image
+	ImageFocusFaces ImageFocus = "faces"
+)
+
+// ImageFormat output format of the Images API
+type ImageFormat string
+
+// noinspection GoUnusedConst
+const (
+	ImageFormatJPG  ImageFormat = "jpg"
+	ImageFormatPNG  ImageFormat = "png"
+	ImageFormatWebP ImageFormat = "webp"
+	ImageFormatGIF  ImageFormat = "gif"
+	ImageFormatAVIF ImageFormat = "avif"
+)
+
+const imageMaxDimension = 4000
+
+var imageBackgroundRegex = regexp.MustCompile(`^[0-9a-fA-F]{6}$`)
+
+// ImageURL builds urls for the Contentful Images API
+// https://www.contentful.com/developers/docs/references/images-api/
+type ImageURL struct {
+	base        string
+	width       int
+	height      int
+	fit         ImageFit
+	focus       ImageFocus
+	radius      int
+	radiusMax   bool
+	quality     int
+	format      ImageFormat
+	progressive bool
+	png8        bool
+	background  string
+}
+
+// NewImageURL initializes a new image url builder for the given file url
+func NewImageURL(fileURL string) *ImageURL {
+	if strings.HasPrefix(fileURL, "//") {
+		fileURL = "https:" + fileURL
+	}
+
+	return &ImageURL{base: fileURL}
+}
+
+// ImageURL returns an image url builder for the file
+func (file *File) ImageURL() *ImageURL {
+	return NewImageURL(file.URL)
+}
+
+// ImageURL returns an image url builder for the asset file of the given locale
+func (asset *Asset) ImageURL(locale string) (*ImageURL, error) {
+	if asset.Fields == nil {
+		return nil, errors.New("asset has no fields")
+	}
+
+	file := asset.Fields.File.Item
+	if file == nil {
+		f, ok := asset.Fields.File.Map[locale]
+		if !ok {
+			return nil, fmt.Errorf("asset has no file for locale %s", locale)
+		}
+		file = &f
+	}
+
+	if file.ContentType != "" && !strings.HasPrefix(file.ContentType, "image/") {
+		return nil, fmt.Errorf("asset file is not an image: %s", file.ContentType)
+	}
+
+	if file.URL == "" {
+		return nil, errors.New("asset file has no url, it might not be processed yet")
+	}
+
+	return file.ImageURL(), nil
+}
+
+// Width sets the width of the image in pixels, 0 keeps the original width
+func (img *ImageURL) Width(width int) *ImageURL {
+	img.width = width
+	return img
+}
+
+// Height sets the height of the image in pixels, 0 keeps the original height
+func (img *ImageURL) Height(height int) *ImageURL {
+	img.height = height
+	return img
+}
+
+// Fit sets the resizing behaviour
+func (img *ImageURL) Fit(fit ImageFit) *ImageURL {
+	img.fit = fit
+	return img
+}
+
+// Focus sets the focus area, only applies to fill, crop and thumb
+func (img *ImageURL) Focus(focus ImageFocus) *ImageURL {
+	img.focus = focus
+	return img
+}
+
+// Radius sets the corner radius in pixels
+func (img *ImageURL) Radius(radius int) *ImageURL {
+	img.radius = radius
+	img.radiusMax = false
+	return img
+}
+
+// RadiusMax crops the image to a circle or an ellipse
+func (img *ImageURL) RadiusMax() *ImageURL {
+	img.radius = 0
+	img.radiusMax = true
+	return img
+}
+
+// Quality sets the compression quality between 1 and 100
+func (img *ImageURL) Quality(quality int) *ImageURL {
+	img.quality = quality
+	return img
+}
+
+// Format sets the output format
+func (img *ImageURL) Format(format ImageFormat) *ImageURL {
+	img.format = format
+	return img
+}
+
+// Progressive requests a progressive jpg, only applies to jpg
+func (img *ImageURL) Progressive() *ImageURL {
+	img.progressive = true
+	return img
+}
+
+// PNG8 requests an 8-bit png, only applies to png
+func (img *ImageURL) PNG8() *ImageURL {
+	img.png8 = true
+	return img
+}
+
+// Background sets the background color as a hex value, e.g. "#ff0000"
+func (img *ImageURL) Background(color string) *ImageURL {
+	color = strings.TrimPrefix(color, "rgb:")
+	img.background = strings.ToLower(strings.TrimPrefix(color, "#"))
+	return img
+}
+
+func (img *ImageURL) clone() *ImageURL {
+	c := *img
+	return &c
+}
+
+// Validate checks that the parameters can be combined
+func (img *ImageURL) Validate() error {
+	if img.base == "" {
+		return errors.New("image url is empty")
+	}
+
+	if img.width < 0 || img.width > imageMaxDimension {
+		return fmt.Errorf("width should be between 0 and %d", imageMaxDimension)
+	}
+
+	if img.height < 0 || img.height > imageMaxDimension {
+		return fmt.Errorf("height should be between 0 and %d", imageMaxDimension)
+	}
+
+	switch img.fit {
+	case "":
+	case ImageFitPad, ImageFitFill, ImageFitScale, ImageFitCrop, ImageFitThumb:
+		if img.width == 0 && img.height == 0 {
+			return fmt.Errorf("fit %s requires a width or a height", img.fit)
+		}
+	default:
+		return fmt.Errorf("unknown fit %s", img.fit)
+	}
+
+	switch img.focus {
+	case "":
+	case ImageFocusCenter, ImageFocusTop, ImageFocusRight, ImageFocusLeft, ImageFocusBottom,
+		ImageFocusTopRight, ImageFocusTopLeft, ImageFocusBottomRight, ImageFocusBottomLeft,
+		ImageFocusFace, ImageFocusFaces:
+		if img.fit != ImageFitFill && img.fit != ImageFitCrop && img.fit != ImageFitThumb {
+			return fmt.Errorf("focus %s requires fit fill, crop or thumb", img.focus)
+		}
+	default:
+		return fmt.Errorf("unknown focus %s", img.focus)
+	}
+
+	if img.radius < 0 {
+		return errors.New("radius should be positive")
+	}
+
+	switch img.format {
+	case "", ImageFormatJPG, ImageFormatPNG, ImageFormatWebP, ImageFormatGIF, ImageFormatAVIF:
+	default:
+		return fmt.Errorf("unknown format %s", img.format)
+	}
+
+	if img.progressive && img.format != ImageFormatJPG {
+		return errors.New("progressive requires format jpg")
+	}
+
+	if img.png8 && img.format != ImageFormatPNG {
+		return errors.New("png8 requires format png")
+	}
+
+	if img.quality != 0 {
+		if img.quality < 1 || img.quality > 100 {
+			return errors.New("quality should be between 1 and 100")
+		}
+
+		if img.png8 {
+			return errors.New("quality can not be used with png8")
+		}
+	}
+
+	if img.background != "" {
+		if !imageBackgroundRegex.MatchString(img.background) {
+			return fmt.Errorf("invalid background color %s", img.background)
+		}
+
+		if img.fit != ImageFitPad && img.radius == 0 && !img.radiusMax {
+			return errors.New("background requires fit pad or a radius")
+		}
+	}
+
+	return nil
+}
+
+// Values constructs the Images API url.Values
+func (img *ImageURL) Values() url.Values {
+	params := url.Values{}
+
+	if img.width != 0 {
+		params.Set("w", strconv.Itoa(img.width))
+	}
+
+	if img.height != 0 {
+		params.Set("h", strconv.Itoa(img.height))
+	}
+
+	if img.fit != "" {
+		params.Set("fit", string(img.fit))
+	}
+
+	if img.focus != "" {
+		params.Set("f", string(img.focus))
+	}
+
+	if img.radiusMax {
+		params.Set("r", "max")
+	} else if img.radius != 0 {
+		params.Set("r", strconv.Itoa(img.radius))
+	}
+
+	if img.quality != 0 {
+		params.Set("q", strconv.Itoa(img.quality))
+	}
+
+	if img.format != "" {
+		params.Set("fm", string(img.format))
+	}
+
+	if img.progressive {
+		params.Set("fl", "progressive")
+	}
+
+	if img.png8 {
+		params.Set("fl", "png8")
+	}
+
+	if img.background != "" {
+		params.Set("bg", "rgb:"+img.background)
+	}
+
+	return params
+}
+
+// Build validates the parameters and returns the image url
+func (img *ImageURL) Build() (string, error) {
+	if err := img.Validate(); err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(img.base)
+	if err != nil {
+		return "", err
+	}
+
+	u.RawQuery = img.Values().Encode()
+
+	return u.String(), nil
+}
+
+// SrcSet returns a srcset attribute value with one candidate per width.
+// If a height is set, it is scaled to keep the aspect ratio of the base size.
+func (img *ImageURL) SrcSet(widths ...int) (string, error) {
+	if len(widths) == 0 {
+		return "", errors.New("srcset requires at least one width")
+	}
+
+	candidates := make([]string, 0, len(widths))
+	for _, width := range widths {
+		candidate := img.clone().Width(width)
+		if img.width != 0 && img.height != 0 {
+			candidate.Height(img.height * width / img.width)
+		}
+
+		u, err := candidate.Build()
+		if err != nil {
+			return "", err
+		}
+
+		candidates = append(candidates, fmt.Sprintf("%s %dw", u, width))
+	}
+
+	return strings.Join(candidates, ", "), nil
+}
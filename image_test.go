@@ -0,0 +1,97 @@
+package contentful
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testImageURL = "//images.ctfassets.net/space/asset/hash/cat.png"
+
+func TestImageURL_Build(t *testing.T) {
+	u, err := NewImageURL(testImageURL).
+		Width(300).
+		Height(200).
+		Fit(ImageFitThumb).
+		Focus(ImageFocusFace).
+		Radius(20).
+		Quality(80).
+		Format(ImageFormatJPG).
+		Progressive().
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, "https://images.ctfassets.net/space/asset/hash/cat.png?f=face&fit=thumb&fl=progressive&fm=jpg&h=200&q=80&r=20&w=300", u)
+
+	u, err = NewImageURL(testImageURL).Width(100).Fit(ImageFitPad).Background("#FF0000").Format(ImageFormatPNG).PNG8().Build()
+	require.NoError(t, err)
+	assert.Equal(t, "https://images.ctfassets.net/space/asset/hash/cat.png?bg=rgb%3Aff0000&fit=pad&fl=png8&fm=png&w=100", u)
+
+	u, err = NewImageURL(testImageURL).RadiusMax().Format(ImageFormatAVIF).Build()
+	require.NoError(t, err)
+	assert.Equal(t, "https://images.ctfassets.net/space/asset/hash/cat.png?fm=avif&r=max", u)
+}
+
+func TestImageURL_Validate(t *testing.T) {
+	tests := map[string]*ImageURL{
+		"empty url":            NewImageURL(""),
+		"width too large":      NewImageURL(testImageURL).Width(4001),
+		"negative height":      NewImageURL(testImageURL).Height(-1),
+		"fit without size":     NewImageURL(testImageURL).Fit(ImageFitFill),
+		"unknown fit":          NewImageURL(testImageURL).Width(10).Fit("stretch"),
+		"focus without fit":    NewImageURL(testImageURL).Focus(ImageFocusFaces),
+		"focus with pad":       NewImageURL(testImageURL).Width(10).Fit(ImageFitPad).Focus(ImageFocusTop),
+		"unknown format":       NewImageURL(testImageURL).Format("bmp"),
+		"progressive webp":     NewImageURL(testImageURL).Format(ImageFormatWebP).Progressive(),
+		"png8 jpg":             NewImageURL(testImageURL).Format(ImageFormatJPG).PNG8(),
+		"quality out of range": NewImageURL(testImageURL).Quality(101),
+		"quality with png8":    NewImageURL(testImageURL).Format(ImageFormatPNG).PNG8().Quality(50),
+		"invalid background":   NewImageURL(testImageURL).Width(10).Fit(ImageFitPad).Background("red"),
+		"background with fill": NewImageURL(testImageURL).Width(10).Fit(ImageFitFill).Background("ffffff"),
+	}
+
+	for name, img := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, img.Validate())
+			_, err := img.Build()
+			assert.Error(t, err)
+		})
+	}
+
+	assert.NoError(t, NewImageURL(testImageURL).RadiusMax().Background("ffffff").Validate())
+	assert.EqualError(t, NewImageURL(testImageURL).Width(4001).Validate(), "width should be between 0 and 4000")
+}
+
+func TestImageURL_SrcSet(t *testing.T) {
+	srcSet, err := NewImageURL(testImageURL).Width(400).Height(200).Fit(ImageFitFill).SrcSet(200, 800)
+	require.NoError(t, err)
+	assert.Equal(t,
+		"https://images.ctfassets.net/space/asset/hash/cat.png?fit=fill&h=100&w=200 200w, "+
+			"https://images.ctfassets.net/space/asset/hash/cat.png?fit=fill&h=400&w=800 800w",
+		srcSet,
+	)
+
+	_, err = NewImageURL(testImageURL).SrcSet()
+	assert.Error(t, err)
+
+	_, err = NewImageURL(testImageURL).SrcSet(5000)
+	assert.Error(t, err)
+}
+
+func TestAsset_ImageURL(t *testing.T) {
+	asset, err := assetFromTestData("asset_1.json")
+	require.NoError(t, err)
+
+	img, err := asset.ImageURL("de")
+	require.NoError(t, err)
+	u, err := img.Width(50).Build()
+	require.NoError(t, err)
+	assert.Equal(t, "https://images.flinkly.com/222ru4k10hm8/3HNzx9gvJScKku4UmcekYw/997663077456077dde5b5be9bd3c1386/d3b8dad44e5066cfb805e2357469ee64.png-de?w=50", u)
+
+	_, err = asset.ImageURL("fr")
+	assert.Error(t, err)
+
+	asset.Fields.File = LocaleItem[File]{Item: &File{URL: "//assets.ctfassets.net/doc.pdf", ContentType: "application/pdf"}}
+	_, err = asset.ImageURL("en-US")
+	assert.Error(t, err)
+}
@@ -0,0 +1,63 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// InvitationsService service
+type InvitationsService service
+
+// Invitation model
+type Invitation struct {
+	Sys       *Sys   `json:"sys,omitempty"`
+	Email     string `json:"email"`
+	FirstName string `json:"firstName,omitempty"`
+	LastName  string `json:"lastName,omitempty"`
+	Role      string `json:"role"`
+	Status    string `json:"status,omitempty"`
+}
+
+// invitations are only available through the pending organization membership feature
+const invitationFeatureHeader = "pending-org-membership"
+
+// Create invites a user to the organization
+func (service *InvitationsService) Create(ctx context.Context, organizationID string, invitation *Invitation) error {
+	bytesArray, err := json.Marshal(invitation)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/organizations/%s/invitations", organizationID)
+
+	req, err := service.c.newRequest(ctx, http.MethodPost, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Enable-Alpha-Feature", invitationFeatureHeader)
+
+	return service.c.do(req, invitation)
+}
+
+// Get returns a single invitation
+func (service *InvitationsService) Get(ctx context.Context, organizationID, invitationID string) (*Invitation, error) {
+	path := fmt.Sprintf("/organizations/%s/invitations/%s", organizationID, invitationID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-Contentful-Enable-Alpha-Feature", invitationFeatureHeader)
+
+	var invitation Invitation
+	if err := service.c.do(req, &invitation); err != nil {
+		return nil, err
+	}
+
+	return &invitation, nil
+}
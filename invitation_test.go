@@ -0,0 +1,80 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvitationsService_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		assertions.Equal(r.RequestURI, "/organizations/"+organizationID+"/invitations")
+		assertions.Equal("pending-org-membership", r.Header.Get("X-Contentful-Enable-Alpha-Feature"))
+
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("jane@example.com", payload["email"])
+		assertions.Equal("member", payload["role"])
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, readTestData("invitation_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	invitation := &Invitation{
+		Email:     "jane@example.com",
+		FirstName: "Jane",
+		LastName:  "Doe",
+		Role:      OrganizationRoleMember,
+	}
+
+	err := cma.Invitations.Create(context.Background(), organizationID, invitation)
+	assertions.Nil(err)
+	assertions.Equal("invitation-1", invitation.Sys.ID)
+	assertions.Equal("pending", invitation.Status)
+}
+
+func TestInvitationsService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/organizations/"+organizationID+"/invitations/invitation-1")
+		assertions.Equal("pending-org-membership", r.Header.Get("X-Contentful-Enable-Alpha-Feature"))
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("invitation_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	invitation, err := cma.Invitations.Get(context.Background(), organizationID, "invitation-1")
+	assertions.Nil(err)
+	assertions.Equal("jane@example.com", invitation.Email)
+}
@@ -48,8 +48,8 @@ func (locale *Locale) GetVersion() int {
 }
 
 // List returns a locales collection
-func (service *LocalesService) List(ctx context.Context, spaceID string, query *Query) (*Collection[Locale], error) {
-	path := fmt.Sprintf("/spaces/%s/locales", spaceID)
+func (service *LocalesService) List(ctx context.Context, env *Environment, query *Query) (*Collection[Locale], error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/locales", env.Sys.Space.Sys.ID, env.Sys.ID)
 	method := "GET"
 
 	req, err := service.c.newRequest(ctx, method, path, nil, nil)
@@ -66,8 +66,8 @@ func (service *LocalesService) List(ctx context.Context, spaceID string, query *
 }
 
 // Get returns a single locale entity
-func (service *LocalesService) Get(ctx context.Context, spaceID, localeID string) (*Locale, error) {
-	path := fmt.Sprintf("/spaces/%s/locales/%s", spaceID, localeID)
+func (service *LocalesService) Get(ctx context.Context, env *Environment, localeID string) (*Locale, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/locales/%s", env.Sys.Space.Sys.ID, env.Sys.ID, localeID)
 	method := "GET"
 
 	req, err := service.c.newRequest(ctx, method, path, nil, nil)
@@ -84,8 +84,8 @@ func (service *LocalesService) Get(ctx context.Context, spaceID, localeID string
 }
 
 // Delete the locale
-func (service *LocalesService) Delete(ctx context.Context, spaceID string, locale *Locale) error {
-	path := fmt.Sprintf("/spaces/%s/locales/%s", spaceID, locale.Sys.ID)
+func (service *LocalesService) Delete(ctx context.Context, env *Environment, locale *Locale) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/locales/%s", env.Sys.Space.Sys.ID, env.Sys.ID, locale.Sys.ID)
 	method := "DELETE"
 
 	req, err := service.c.newRequest(ctx, method, path, nil, nil)
@@ -100,7 +100,7 @@ func (service *LocalesService) Delete(ctx context.Context, spaceID string, local
 }
 
 // Upsert updates or creates a new locale entity
-func (service *LocalesService) Upsert(ctx context.Context, spaceID string, locale *Locale) error {
+func (service *LocalesService) Upsert(ctx context.Context, env *Environment, locale *Locale) error {
 	bytesArray, err := json.Marshal(locale)
 	if err != nil {
 		return err
@@ -110,10 +110,10 @@ func (service *LocalesService) Upsert(ctx context.Context, spaceID string, local
 	var method string
 
 	if locale.Sys != nil && locale.Sys.CreatedAt != "" {
-		path = fmt.Sprintf("/spaces/%s/locales/%s", spaceID, locale.Sys.ID)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/locales/%s", env.Sys.Space.Sys.ID, env.Sys.ID, locale.Sys.ID)
 		method = "PUT"
 	} else {
-		path = fmt.Sprintf("/spaces/%s/locales", spaceID)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/locales", env.Sys.Space.Sys.ID, env.Sys.ID)
 		method = "POST"
 	}
 
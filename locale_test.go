@@ -17,7 +17,7 @@ func TestLocalesService_List(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/locales")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/locales")
 
 		checkHeaders(r, assertions)
 
@@ -33,7 +33,7 @@ func TestLocalesService_List(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	collection, err := cma.Locales.List(context.Background(), spaceID, nil)
+	collection, err := cma.Locales.List(context.Background(), env, nil)
 	assertions.Nil(err)
 	locale := collection.Items
 	assertions.Equal("34N35DoyUQAtaKwWTgZs34", locale[0].Sys.ID)
@@ -45,7 +45,7 @@ func TestLocalesService_Get(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/locales/4aGeQYgByqQFJtToAOh2JJ")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/locales/4aGeQYgByqQFJtToAOh2JJ")
 
 		checkHeaders(r, assertions)
 
@@ -61,7 +61,7 @@ func TestLocalesService_Get(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	locale, err := cma.Locales.Get(context.Background(), spaceID, "4aGeQYgByqQFJtToAOh2JJ")
+	locale, err := cma.Locales.Get(context.Background(), env, "4aGeQYgByqQFJtToAOh2JJ")
 	assertions.Nil(err)
 	assertions.Equal("U.S. English", locale.Name)
 	assertions.Equal("en-US", locale.Code)
@@ -73,7 +73,7 @@ func TestLocalesService_Get_2(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/locales/4aGeQYgByqQFJtToAOh2JJ")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/locales/4aGeQYgByqQFJtToAOh2JJ")
 
 		checkHeaders(r, assertions)
 
@@ -89,7 +89,7 @@ func TestLocalesService_Get_2(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	_, err = cma.Locales.Get(context.Background(), spaceID, "4aGeQYgByqQFJtToAOh2JJ")
+	_, err = cma.Locales.Get(context.Background(), env, "4aGeQYgByqQFJtToAOh2JJ")
 	assertions.NotNil(err)
 }
 
@@ -99,7 +99,7 @@ func TestLocalesService_Upsert_Create(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "POST")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/locales")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/locales")
 
 		checkHeaders(r, assertions)
 
@@ -126,7 +126,7 @@ func TestLocalesService_Upsert_Create(t *testing.T) {
 		Code: "de-AT",
 	}
 
-	err = cma.Locales.Upsert(context.Background(), spaceID, locale)
+	err = cma.Locales.Upsert(context.Background(), env, locale)
 	assertions.Nil(err)
 }
 
@@ -136,7 +136,7 @@ func TestLocalesService_Upsert_Update(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/locales/4aGeQYgByqQFJtToAOh2JJ")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/locales/4aGeQYgByqQFJtToAOh2JJ")
 
 		checkHeaders(r, assertions)
 
@@ -164,7 +164,7 @@ func TestLocalesService_Upsert_Update(t *testing.T) {
 	locale.Name = "modified-name"
 	locale.Code = "modified-code"
 
-	err = cma.Locales.Upsert(context.Background(), spaceID, locale)
+	err = cma.Locales.Upsert(context.Background(), env, locale)
 	assertions.Nil(err)
 }
 
@@ -174,7 +174,7 @@ func TestLocalesService_Delete(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "DELETE")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/locales/4aGeQYgByqQFJtToAOh2JJ")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/locales/4aGeQYgByqQFJtToAOh2JJ")
 		checkHeaders(r, assertions)
 
 		w.WriteHeader(200)
@@ -193,6 +193,6 @@ func TestLocalesService_Delete(t *testing.T) {
 	assertions.Nil(err)
 
 	// delete locale
-	err = cma.Locales.Delete(context.Background(), spaceID, locale)
+	err = cma.Locales.Delete(context.Background(), env, locale)
 	assertions.Nil(err)
 }
@@ -0,0 +1,155 @@
+package contentful
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// earthRadius in km
+const earthRadius = 6371.0
+
+// Location model, the value of Location fields
+type Location struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// Validate returns an error if the latitude or longitude is out of range
+func (location Location) Validate() error {
+	if math.IsNaN(location.Lat) || location.Lat < -90 || location.Lat > 90 {
+		return fmt.Errorf("latitude %v should be between -90 and 90", location.Lat)
+	}
+
+	if math.IsNaN(location.Lon) || location.Lon < -180 || location.Lon > 180 {
+		return fmt.Errorf("longitude %v should be between -180 and 180", location.Lon)
+	}
+
+	return nil
+}
+
+// String returns the location as lat,lon like it is used in queries
+func (location Location) String() string {
+	return strconv.FormatFloat(location.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(location.Lon, 'f', -1, 64)
+}
+
+// DistanceTo returns the great-circle distance in km
+func (location Location) DistanceTo(other Location) float64 {
+	lat1 := location.Lat * math.Pi / 180
+	lat2 := other.Lat * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (other.Lon - location.Lon) * math.Pi / 180
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// UnmarshalJSON for custom json unmarshaling, coordinates may be numbers or numeric strings
+func (location *Location) UnmarshalJSON(data []byte) error {
+	var payload struct {
+		Lat json.Number `json:"lat"`
+		Lon json.Number `json:"lon"`
+	}
+
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	lat, err := payload.Lat.Float64()
+	if err != nil {
+		return fmt.Errorf("invalid latitude %q", payload.Lat)
+	}
+
+	lon, err := payload.Lon.Float64()
+	if err != nil {
+		return fmt.Errorf("invalid longitude %q", payload.Lon)
+	}
+
+	location.Lat = lat
+	location.Lon = lon
+
+	return location.Validate()
+}
+
+// LocationFromValue converts a Location field value as decoded into Entry.Fields
+func LocationFromValue(value any) (Location, error) {
+	var location Location
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return location, err
+	}
+
+	err = json.Unmarshal(data, &location)
+
+	return location, err
+}
+
+// EntryLocator returns a function reading the location field of an entry. The
+// locale is used for entries fetched with all locales, e.g. from the CMA.
+func EntryLocator(field, locale string) func(entry Entry) (Location, bool) {
+	return func(entry Entry) (Location, bool) {
+		value, ok := entry.Fields[field]
+		if !ok {
+			return Location{}, false
+		}
+
+		if localized, ok := value.(map[string]any); ok {
+			if _, ok := localized["lat"]; !ok {
+				if value, ok = localized[locale]; !ok {
+					return Location{}, false
+				}
+			}
+		}
+
+		location, err := LocationFromValue(value)
+		if err != nil {
+			return Location{}, false
+		}
+
+		return location, true
+	}
+}
+
+// Nearby is an item with its distance to the origin of SortByDistance
+type Nearby[T any] struct {
+	Item     T
+	Location Location
+	// Distance to the origin in km
+	Distance float64
+}
+
+// SortByDistance returns the items ordered by their distance to origin, closest
+// first. Items for which locate returns false are left out.
+func SortByDistance[T any](items []T, origin Location, locate func(item T) (Location, bool)) []Nearby[T] {
+	nearby := make([]Nearby[T], 0, len(items))
+	for _, item := range items {
+		location, ok := locate(item)
+		if !ok {
+			continue
+		}
+
+		nearby = append(nearby, Nearby[T]{Item: item, Location: location, Distance: origin.DistanceTo(location)})
+	}
+
+	sort.SliceStable(nearby, func(i, j int) bool {
+		return nearby[i].Distance < nearby[j].Distance
+	})
+
+	return nearby
+}
+
+// WithinDistance returns the items at most maxDistance km away from origin, closest first
+func WithinDistance[T any](items []T, origin Location, maxDistance float64, locate func(item T) (Location, bool)) []Nearby[T] {
+	nearby := SortByDistance(items, origin, locate)
+	for i, item := range nearby {
+		if item.Distance > maxDistance {
+			return nearby[:i]
+		}
+	}
+
+	return nearby
+}
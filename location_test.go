@@ -0,0 +1,74 @@
+package contentful
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocation_JSON(t *testing.T) {
+	assertions := assert.New(t)
+
+	var location Location
+	err := json.Unmarshal([]byte(`{"lat": 52.5200066, "lon": 13.404954}`), &location)
+	assertions.Nil(err)
+	assertions.Equal(Location{Lat: 52.5200066, Lon: 13.404954}, location)
+
+	err = json.Unmarshal([]byte(`{"lat": "48.8566", "lon": "2.3522"}`), &location)
+	assertions.Nil(err)
+	assertions.Equal(Location{Lat: 48.8566, Lon: 2.3522}, location)
+
+	data, err := json.Marshal(Location{Lat: 1.5, Lon: -2.25})
+	assertions.Nil(err)
+	assertions.Equal(`{"lat":1.5,"lon":-2.25}`, string(data))
+
+	assertions.NotNil(json.Unmarshal([]byte(`{"lat": 91, "lon": 0}`), &location))
+	assertions.NotNil(json.Unmarshal([]byte(`{"lat": "north", "lon": 0}`), &location))
+}
+
+func TestLocation_Validate(t *testing.T) {
+	assertions := assert.New(t)
+
+	assertions.Nil(Location{Lat: -90, Lon: 180}.Validate())
+	assertions.NotNil(Location{Lat: -90.1, Lon: 0}.Validate())
+	assertions.NotNil(Location{Lat: 0, Lon: 180.5}.Validate())
+	assertions.NotNil(Location{Lat: math.NaN(), Lon: 0}.Validate())
+}
+
+func TestLocation_DistanceTo(t *testing.T) {
+	assertions := assert.New(t)
+
+	berlin := Location{Lat: 52.52, Lon: 13.405}
+	paris := Location{Lat: 48.8566, Lon: 2.3522}
+
+	assertions.InDelta(878, berlin.DistanceTo(paris), 2)
+	assertions.InDelta(berlin.DistanceTo(paris), paris.DistanceTo(berlin), 0.0001)
+	assertions.Equal(float64(0), berlin.DistanceTo(berlin))
+}
+
+func TestSortByDistance(t *testing.T) {
+	assertions := assert.New(t)
+
+	entries := []Entry{
+		{Sys: &Sys{ID: "paris"}, Fields: map[string]any{"store": map[string]any{"en-US": map[string]any{"lat": 48.8566, "lon": 2.3522}}}},
+		{Sys: &Sys{ID: "unknown"}, Fields: map[string]any{}},
+		{Sys: &Sys{ID: "potsdam"}, Fields: map[string]any{"store": map[string]any{"en-US": map[string]any{"lat": 52.3906, "lon": 13.0645}}}},
+		{Sys: &Sys{ID: "hamburg"}, Fields: map[string]any{"store": map[string]any{"lat": 53.5511, "lon": 9.9937}}},
+		{Sys: &Sys{ID: "german"}, Fields: map[string]any{"store": map[string]any{"de-DE": map[string]any{"lat": 0, "lon": 0}}}},
+	}
+
+	berlin := Location{Lat: 52.52, Lon: 13.405}
+
+	nearby := SortByDistance(entries, berlin, EntryLocator("store", "en-US"))
+	assertions.Equal(3, len(nearby))
+	assertions.Equal("potsdam", nearby[0].Item.Sys.ID)
+	assertions.Equal("hamburg", nearby[1].Item.Sys.ID)
+	assertions.Equal("paris", nearby[2].Item.Sys.ID)
+	assertions.InDelta(27, nearby[0].Distance, 2)
+	assertions.Equal(Location{Lat: 52.3906, Lon: 13.0645}, nearby[0].Location)
+
+	nearby = WithinDistance(entries, berlin, 300, EntryLocator("store", "en-US"))
+	assertions.Equal(2, len(nearby))
+}
@@ -71,7 +71,7 @@ func (service *MembershipsService) Get(ctx context.Context, spaceID, membershipI
 	}
 
 	var membership Membership
-	if ok := service.c.do(req, &membership); ok != nil {
+	if err := service.c.do(req, &membership); err != nil {
 		return nil, err
 	}
 
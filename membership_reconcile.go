@@ -0,0 +1,283 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// AdminRole makes a user space admin when used as a role in an AccessState
+const AdminRole = "admin"
+
+// AccessState is the desired space access: user email to space id to role ids.
+// Use AdminRole to make a user space admin.
+type AccessState map[string]map[string][]string
+
+// AccessChangeType kind of an AccessChange
+type AccessChangeType string
+
+// noinspection GoUnusedConst
+const (
+	AccessChangeCreate AccessChangeType = "create"
+	AccessChangeUpdate AccessChangeType = "update"
+	AccessChangeDelete AccessChangeType = "delete"
+)
+
+// AccessChange is a single space membership change planned by PlanAccess
+type AccessChange struct {
+	Type    AccessChangeType
+	SpaceID string
+	Email   string
+	Admin   bool
+	Roles   []string
+
+	// Membership is the existing membership for updates and deletes
+	Membership *Membership
+}
+
+func (change AccessChange) String() string {
+	switch change.Type {
+	case AccessChangeDelete:
+		return fmt.Sprintf("%s %s from space %s", change.Type, change.Email, change.SpaceID)
+	default:
+		return fmt.Sprintf("%s %s in space %s: admin=%t roles=%s", change.Type, change.Email, change.SpaceID, change.Admin, strings.Join(change.Roles, ","))
+	}
+}
+
+// PlanAccess compares the space memberships of the given spaces with the desired
+// state and returns the minimal list of changes. If no spaces are given, the
+// spaces of the desired state are used. Users missing from the desired state lose
+// their membership of the managed spaces. Access granted through teams is not affected,
+// users who only have access through a team are not given a membership.
+// Users with neither roles nor AdminRole in a space fail the plan with an AccessStateError.
+func (service *MembershipsService) PlanAccess(ctx context.Context, desired AccessState, spaceIDs ...string) ([]AccessChange, error) {
+	desiredBySpace := map[string]map[string][]string{}
+	var invalid []string
+	for email, spaces := range desired {
+		for spaceID, roles := range spaces {
+			if len(roles) == 0 {
+				invalid = append(invalid, fmt.Sprintf("%s in space %s", email, spaceID))
+				continue
+			}
+			if desiredBySpace[spaceID] == nil {
+				desiredBySpace[spaceID] = map[string][]string{}
+			}
+			desiredBySpace[spaceID][strings.ToLower(email)] = roles
+		}
+	}
+
+	if len(invalid) > 0 {
+		sort.Strings(invalid)
+		return nil, AccessStateError{Grants: invalid}
+	}
+
+	if len(spaceIDs) == 0 {
+		for spaceID := range desiredBySpace {
+			spaceIDs = append(spaceIDs, spaceID)
+		}
+	}
+	sort.Strings(spaceIDs)
+
+	var changes []AccessChange
+	for _, spaceID := range spaceIDs {
+		spaceChanges, err := service.planSpaceAccess(ctx, spaceID, desiredBySpace[spaceID])
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, spaceChanges...)
+	}
+
+	return changes, nil
+}
+
+func (service *MembershipsService) planSpaceAccess(ctx context.Context, spaceID string, desired map[string][]string) ([]AccessChange, error) {
+	users, err := service.c.Users.ListSpaceUsers(ctx, spaceID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	allUsers, err := users.collectAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	emails := map[string]string{}
+	userIDs := map[string]string{}
+	for _, user := range allUsers {
+		if user.Sys != nil {
+			email := strings.ToLower(user.Email)
+			emails[user.Sys.ID] = email
+			userIDs[email] = user.Sys.ID
+		}
+	}
+
+	memberships, err := service.List(ctx, spaceID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	allMemberships, err := memberships.collectAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []AccessChange
+	for i := range allMemberships {
+		membership := &allMemberships[i]
+		if membership.User.Sys == nil {
+			continue
+		}
+
+		// memberships of users we can not resolve are left untouched
+		email, ok := emails[membership.User.Sys.ID]
+		if !ok && membership.Email != "" {
+			email, ok = strings.ToLower(membership.Email), true
+			userIDs[email] = membership.User.Sys.ID
+		}
+		if !ok {
+			continue
+		}
+
+		roles, ok := desired[email]
+		if !ok {
+			changes = append(changes, AccessChange{
+				Type:       AccessChangeDelete,
+				SpaceID:    spaceID,
+				Email:      email,
+				Membership: membership,
+			})
+			continue
+		}
+
+		admin, roleIDs := splitAccessRoles(roles)
+		if admin == membership.Admin && equalStrings(roleIDs, membershipRoleIDs(membership)) {
+			continue
+		}
+
+		changes = append(changes, AccessChange{
+			Type:       AccessChangeUpdate,
+			SpaceID:    spaceID,
+			Email:      email,
+			Admin:      admin,
+			Roles:      roleIDs,
+			Membership: membership,
+		})
+	}
+
+	var missing []string
+	for email := range desired {
+		// users of the space are either matched to their membership by user id
+		// above or only have access through a team
+		if _, ok := userIDs[email]; ok {
+			continue
+		}
+		missing = append(missing, email)
+	}
+	sort.Strings(missing)
+
+	for _, email := range missing {
+		admin, roleIDs := splitAccessRoles(desired[email])
+		changes = append(changes, AccessChange{
+			Type:    AccessChangeCreate,
+			SpaceID: spaceID,
+			Email:   email,
+			Admin:   admin,
+			Roles:   roleIDs,
+		})
+	}
+
+	return changes, nil
+}
+
+// ApplyAccess applies the changes returned by PlanAccess
+func (service *MembershipsService) ApplyAccess(ctx context.Context, changes []AccessChange) error {
+	for _, change := range changes {
+		var err error
+
+		switch change.Type {
+		case AccessChangeCreate:
+			err = service.Upsert(ctx, change.SpaceID, &Membership{
+				Admin: change.Admin,
+				Roles: roleLinks(change.Roles),
+				Email: change.Email,
+			})
+		case AccessChangeUpdate:
+			membership := *change.Membership
+			membership.Admin = change.Admin
+			membership.Roles = roleLinks(change.Roles)
+			err = service.Upsert(ctx, change.SpaceID, &membership)
+		case AccessChangeDelete:
+			err = service.Delete(ctx, change.SpaceID, change.Membership.Sys.ID)
+		default:
+			err = fmt.Errorf("unknown access change %s", change.Type)
+		}
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", change, err)
+		}
+	}
+
+	return nil
+}
+
+// ReconcileAccess plans and applies the changes needed to reach the desired state
+func (service *MembershipsService) ReconcileAccess(ctx context.Context, desired AccessState, spaceIDs ...string) ([]AccessChange, error) {
+	changes, err := service.PlanAccess(ctx, desired, spaceIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, service.ApplyAccess(ctx, changes)
+}
+
+func splitAccessRoles(roles []string) (bool, []string) {
+	admin := false
+	roleIDs := []string{}
+	for _, role := range roles {
+		if role == AdminRole {
+			admin = true
+			continue
+		}
+		roleIDs = append(roleIDs, role)
+	}
+	sort.Strings(roleIDs)
+
+	return admin, roleIDs
+}
+
+func membershipRoleIDs(membership *Membership) []string {
+	roleIDs := []string{}
+	for _, role := range membership.Roles {
+		if role.Sys != nil {
+			roleIDs = append(roleIDs, role.Sys.ID)
+		}
+	}
+	sort.Strings(roleIDs)
+
+	return roleIDs
+}
+
+func roleLinks(roleIDs []string) []Roles {
+	roles := make([]Roles, 0, len(roleIDs))
+	for _, id := range roleIDs {
+		roles = append(roles, Roles{Sys: &Sys{Type: "Link", LinkType: "Role", ID: id}})
+	}
+
+	return roles
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,242 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const reconcileMemberships = `{
+  "total": 3,
+  "items": [
+    {
+      "admin": true,
+      "sys": {"type": "SpaceMembership", "id": "membership-john", "version": 1},
+      "user": {"sys": {"type": "Link", "linkType": "User", "id": "4hRixSwbw45w5qbVPio"}},
+      "roles": []
+    },
+    {
+      "admin": false,
+      "sys": {"type": "SpaceMembership", "id": "membership-jane", "version": 3},
+      "user": {"sys": {"type": "Link", "linkType": "User", "id": "7BslKh9TdKGOK41VmLDjFZ"}},
+      "roles": [{"sys": {"type": "Link", "linkType": "Role", "id": "editor"}}]
+    },
+    {
+      "admin": false,
+      "sys": {"type": "SpaceMembership", "id": "membership-unknown", "version": 1},
+      "user": {"sys": {"type": "Link", "linkType": "User", "id": "unknown-user"}},
+      "roles": []
+    }
+  ]
+}`
+
+// reconcileUsers are the users of user.json and a user with access through a team only
+const reconcileUsers = `{
+  "total": 3,
+  "items": [
+    {"email": "j.doe@labdigital.nl", "sys": {"type": "User", "id": "4hRixSwbw45w5qbVPio"}},
+    {"email": "Jane@example.com", "sys": {"type": "User", "id": "7BslKh9TdKGOK41VmLDjFZ"}},
+    {"email": "team@example.com", "sys": {"type": "User", "id": "team-user"}}
+  ]
+}`
+
+type reconcileRequest struct {
+	Method  string
+	Path    string
+	Version string
+	Body    map[string]interface{}
+}
+
+func newReconcileServer(assertions *assert.Assertions, memberships string) (*httptest.Server, *[]reconcileRequest) {
+	var mu sync.Mutex
+	var writes []reconcileRequest
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkHeaders(r, assertions)
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/spaces/"+spaceID+"/users":
+			_, _ = fmt.Fprintln(w, reconcileUsers)
+		case r.Method == "GET" && r.URL.Path == "/spaces/"+spaceID+"/space_memberships":
+			_, _ = fmt.Fprintln(w, memberships)
+		default:
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+
+			mu.Lock()
+			writes = append(writes, reconcileRequest{
+				Method:  r.Method,
+				Path:    r.URL.Path,
+				Version: r.Header.Get("X-Contentful-Version"),
+				Body:    body,
+			})
+			mu.Unlock()
+
+			if r.Method == "DELETE" {
+				w.WriteHeader(204)
+				return
+			}
+			_, _ = fmt.Fprintln(w, `{"sys":{"id":"membership-new"}}`)
+		}
+	})
+
+	return httptest.NewServer(handler), &writes
+}
+
+func TestMembershipsService_PlanAccess(t *testing.T) {
+	assertions := assert.New(t)
+
+	server, writes := newReconcileServer(assertions, reconcileMemberships)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	changes, err := cma.Memberships.PlanAccess(context.Background(), AccessState{
+		"jane@example.com": {spaceID: {"editor", "translator"}},
+		"new@example.com":  {spaceID: {AdminRole}},
+	})
+	assertions.Nil(err)
+	assertions.Equal(0, len(*writes))
+
+	assertions.Equal(3, len(changes))
+
+	assertions.Equal(AccessChangeDelete, changes[0].Type)
+	assertions.Equal("j.doe@labdigital.nl", changes[0].Email)
+	assertions.Equal("membership-john", changes[0].Membership.Sys.ID)
+
+	assertions.Equal(AccessChangeUpdate, changes[1].Type)
+	assertions.Equal("jane@example.com", changes[1].Email)
+	assertions.Equal([]string{"editor", "translator"}, changes[1].Roles)
+	assertions.False(changes[1].Admin)
+
+	assertions.Equal(AccessChangeCreate, changes[2].Type)
+	assertions.Equal("new@example.com", changes[2].Email)
+	assertions.True(changes[2].Admin)
+	assertions.Equal([]string{}, changes[2].Roles)
+}
+
+func TestMembershipsService_PlanAccess_NoChanges(t *testing.T) {
+	assertions := assert.New(t)
+
+	server, _ := newReconcileServer(assertions, reconcileMemberships)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	changes, err := cma.Memberships.PlanAccess(context.Background(), AccessState{
+		"J.Doe@labdigital.nl": {spaceID: {AdminRole}},
+		"jane@example.com":    {spaceID: {"editor"}},
+	})
+	assertions.Nil(err)
+	assertions.Equal(0, len(changes))
+}
+
+func TestMembershipsService_PlanAccess_NoRoles(t *testing.T) {
+	assertions := assert.New(t)
+
+	server, writes := newReconcileServer(assertions, reconcileMemberships)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	changes, err := cma.Memberships.ReconcileAccess(context.Background(), AccessState{
+		"jane@example.com":    {spaceID: {}},
+		"new@example.com":     {spaceID: nil},
+		"j.doe@labdigital.nl": {spaceID: {AdminRole}},
+	})
+	assertions.Nil(changes)
+	assertions.Equal(0, len(*writes))
+
+	var accessErr AccessStateError
+	assertions.True(errors.As(err, &accessErr))
+	assertions.Equal([]string{"jane@example.com in space " + spaceID, "new@example.com in space " + spaceID}, accessErr.Grants)
+}
+
+func TestMembershipsService_ReconcileAccess(t *testing.T) {
+	assertions := assert.New(t)
+
+	server, writes := newReconcileServer(assertions, reconcileMemberships)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	changes, err := cma.Memberships.ReconcileAccess(context.Background(), AccessState{
+		"jane@example.com": {spaceID: {"translator"}},
+		"new@example.com":  {spaceID: {"editor"}},
+	}, spaceID)
+	assertions.Nil(err)
+	assertions.Equal(3, len(changes))
+	assertions.Equal(3, len(*writes))
+
+	requests := *writes
+	assertions.Equal("DELETE", requests[0].Method)
+	assertions.Equal("/spaces/"+spaceID+"/space_memberships/membership-john", requests[0].Path)
+
+	assertions.Equal("PUT", requests[1].Method)
+	assertions.Equal("/spaces/"+spaceID+"/space_memberships/membership-jane", requests[1].Path)
+	assertions.Equal("3", requests[1].Version)
+	assertions.Equal("translator", requests[1].Body["roles"].([]interface{})[0].(map[string]interface{})["sys"].(map[string]interface{})["id"])
+
+	assertions.Equal("POST", requests[2].Method)
+	assertions.Equal("/spaces/"+spaceID+"/space_memberships", requests[2].Path)
+	assertions.Equal("new@example.com", requests[2].Body["email"])
+}
+
+func TestMembershipsService_PlanAccess_ExistingAccess(t *testing.T) {
+	assertions := assert.New(t)
+
+	server, writes := newReconcileServer(assertions, `{
+  "total": 2,
+  "items": [
+    {
+      "admin": false,
+      "sys": {"type": "SpaceMembership", "id": "membership-jane", "version": 3},
+      "user": {"sys": {"type": "Link", "linkType": "User", "id": "7BslKh9TdKGOK41VmLDjFZ"}},
+      "roles": [{"sys": {"type": "Link", "linkType": "Role", "id": "editor"}}]
+    },
+    {
+      "admin": false,
+      "sys": {"type": "SpaceMembership", "id": "membership-invited", "version": 1},
+      "user": {"sys": {"type": "Link", "linkType": "User", "id": "invited-user"}},
+      "email": "Invited@example.com",
+      "roles": [{"sys": {"type": "Link", "linkType": "Role", "id": "editor"}}]
+    }
+  ]
+}`)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	desired := AccessState{
+		"jane@example.com":    {spaceID: {"editor"}},
+		"team@example.com":    {spaceID: {"editor"}},
+		"invited@example.com": {spaceID: {"editor"}},
+	}
+
+	// team@example.com only has access through a team
+	changes, err := cma.Memberships.PlanAccess(context.Background(), desired)
+	assertions.Nil(err)
+	assertions.Equal(0, len(changes))
+
+	changes, err = cma.Memberships.ReconcileAccess(context.Background(), desired)
+	assertions.Nil(err)
+	assertions.Equal(0, len(changes))
+	assertions.Equal(0, len(*writes))
+}
@@ -90,7 +90,7 @@ func TestMembershipsService_Get_2(t *testing.T) {
 	cma.BaseURL = server.URL
 
 	_, err = cma.Memberships.Get(context.Background(), spaceID, "0xWanD4AZI2AR35wW9q51n")
-	assertions.Nil(err)
+	assertions.NotNil(err)
 }
 
 func TestMembershipsService_Upsert_Create(t *testing.T) {
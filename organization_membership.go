@@ -0,0 +1,102 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// OrganizationMembershipsService service
+type OrganizationMembershipsService service
+
+// noinspection GoUnusedConst
+const (
+	OrganizationRoleOwner  = "owner"
+	OrganizationRoleAdmin  = "admin"
+	OrganizationRoleMember = "member"
+)
+
+// OrganizationMembership model
+type OrganizationMembership struct {
+	Sys    *Sys   `json:"sys,omitempty"`
+	Role   string `json:"role"`
+	Status bool   `json:"status,omitempty"`
+}
+
+// GetVersion returns entity version
+func (membership *OrganizationMembership) GetVersion() int {
+	version := 1
+	if membership.Sys != nil {
+		version = membership.Sys.Version
+	}
+
+	return version
+}
+
+// List returns an organization memberships collection
+func (service *OrganizationMembershipsService) List(ctx context.Context, organizationID string, query *Query) (*Collection[OrganizationMembership], error) {
+	path := fmt.Sprintf("/organizations/%s/organization_memberships", organizationID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := newCollection[OrganizationMembership](query, service.c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return col, nil
+}
+
+// Get returns a single organization membership
+func (service *OrganizationMembershipsService) Get(ctx context.Context, organizationID, membershipID string) (*OrganizationMembership, error) {
+	path := fmt.Sprintf("/organizations/%s/organization_memberships/%s", organizationID, membershipID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var membership OrganizationMembership
+	if err := service.c.do(req, &membership); err != nil {
+		return nil, err
+	}
+
+	return &membership, nil
+}
+
+// Update changes the role of an organization membership
+func (service *OrganizationMembershipsService) Update(ctx context.Context, organizationID string, membership *OrganizationMembership) error {
+	bytesArray, err := json.Marshal(map[string]string{"role": membership.Role})
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/organizations/%s/organization_memberships/%s", organizationID, membership.Sys.ID)
+
+	req, err := service.c.newRequest(ctx, http.MethodPut, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(membership.GetVersion()))
+
+	return service.c.do(req, membership)
+}
+
+// Delete removes a user from the organization
+func (service *OrganizationMembershipsService) Delete(ctx context.Context, organizationID, membershipID string) error {
+	path := fmt.Sprintf("/organizations/%s/organization_memberships/%s", organizationID, membershipID)
+
+	req, err := service.c.newRequest(ctx, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, nil)
+}
@@ -0,0 +1,127 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrganizationMembershipsService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/organizations/"+organizationID+"/organization_memberships")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("organization_membership.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	collection, err := cma.OrganizationMemberships.List(context.Background(), organizationID, nil)
+	assertions.Nil(err)
+	memberships := collection.Items
+	assertions.Equal(1, len(memberships))
+	assertions.Equal(OrganizationRoleMember, memberships[0].Role)
+	assertions.Equal("7BslKh9TdKGOK41VmLDjFZ", memberships[0].Sys.User.Sys.ID)
+}
+
+func TestOrganizationMembershipsService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/organizations/"+organizationID+"/organization_memberships/org-membership-1")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("organization_membership_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	membership, err := cma.OrganizationMemberships.Get(context.Background(), organizationID, "org-membership-1")
+	assertions.Nil(err)
+	assertions.Equal("org-membership-1", membership.Sys.ID)
+	assertions.True(membership.Status)
+}
+
+func TestOrganizationMembershipsService_Update(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "PUT")
+		assertions.Equal(r.RequestURI, "/organizations/"+organizationID+"/organization_memberships/org-membership-1")
+		assertions.Equal("2", r.Header.Get("X-Contentful-Version"))
+
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal(map[string]interface{}{"role": "admin"}, payload)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("organization_membership_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	membership := &OrganizationMembership{
+		Sys:  &Sys{ID: "org-membership-1", Version: 2},
+		Role: OrganizationRoleAdmin,
+	}
+
+	err := cma.OrganizationMemberships.Update(context.Background(), organizationID, membership)
+	assertions.Nil(err)
+}
+
+func TestOrganizationMembershipsService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "DELETE")
+		assertions.Equal(r.RequestURI, "/organizations/"+organizationID+"/organization_memberships/org-membership-1")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(204)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	err := cma.OrganizationMemberships.Delete(context.Background(), organizationID, "org-membership-1")
+	assertions.Nil(err)
+}
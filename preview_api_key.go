@@ -0,0 +1,53 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// PreviewAPIKeysService service
+type PreviewAPIKeysService service
+
+// List returns all preview api keys collection
+func (service *PreviewAPIKeysService) List(ctx context.Context, spaceID string, query *Query) (*Collection[PreviewAPIKey], error) {
+	path := fmt.Sprintf("/spaces/%s/preview_api_keys", spaceID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := newCollection[PreviewAPIKey](query, service.c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return col, nil
+}
+
+// Get returns a single preview api key entity
+func (service *PreviewAPIKeysService) Get(ctx context.Context, spaceID, previewAPIKeyID string) (*PreviewAPIKey, error) {
+	path := fmt.Sprintf("/spaces/%s/preview_api_keys/%s", spaceID, previewAPIKeyID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var previewAPIKey PreviewAPIKey
+	if err := service.c.do(req, &previewAPIKey); err != nil {
+		return nil, err
+	}
+
+	return &previewAPIKey, nil
+}
+
+// GetForAPIKey returns the preview api key linked to the api key
+func (service *PreviewAPIKeysService) GetForAPIKey(ctx context.Context, spaceID string, apiKey *APIKey) (*PreviewAPIKey, error) {
+	if apiKey.PreviewAPIKey.Sys.ID == "" {
+		return nil, fmt.Errorf("api key %s has no preview api key", apiKey.Name)
+	}
+
+	return service.Get(ctx, spaceID, apiKey.PreviewAPIKey.Sys.ID)
+}
@@ -0,0 +1,72 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreviewAPIKeysService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/preview_api_keys")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("preview_api_key.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	collection, err := cma.PreviewAPIKeys.List(context.Background(), spaceID, nil)
+	assertions.Nil(err)
+	keys := collection.Items
+	assertions.Equal(1, len(keys))
+	assertions.Equal("pr3v13w70k3n", keys[0].AccessToken)
+}
+
+func TestPreviewAPIKeysService_GetForAPIKey(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/preview_api_keys/1Mx3FqXX5XCJDtNpVW4BZI")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("preview_api_key_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	key, err := apiKeyFromTestData("api_key_1.json")
+	assertions.Nil(err)
+
+	preview, err := cma.PreviewAPIKeys.GetForAPIKey(context.Background(), spaceID, key)
+	assertions.Nil(err)
+	assertions.Equal("pr3v13w70k3n", preview.AccessToken)
+	assertions.Equal("staging", preview.Environments[0].Sys.ID)
+
+	_, err = cma.PreviewAPIKeys.GetForAPIKey(context.Background(), spaceID, &APIKey{Name: "no preview"})
+	assertions.NotNil(err)
+}
@@ -1,6 +1,8 @@
 package contentful
 
 import (
+	"errors"
+	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,9 +31,11 @@ type Query struct {
 	within      map[string]string
 	order       []string
 	limit       uint16
-	skip        uint16
+	skip        int
 	mime        string
 	locale      string
+	params      map[string][]string
+	err         error
 }
 
 // NewQuery initializes a new query
@@ -60,9 +64,43 @@ func NewQuery() *Query {
 		skip:        0,
 		mime:        "",
 		locale:      "",
+		params:      make(map[string][]string),
 	}
 }
 
+// Clone returns a deep copy of the query, changes to the copy do not affect the original
+func (q *Query) Clone() *Query {
+	clone := *q
+	clone.fields = append([]string{}, q.fields...)
+	clone.e = cloneMap(q.e)
+	clone.ne = cloneMap(q.ne)
+	clone.all = cloneMap(q.all)
+	clone.in = cloneMap(q.in)
+	clone.nin = cloneMap(q.nin)
+	clone.exists = append([]string{}, q.exists...)
+	clone.notExists = append([]string{}, q.notExists...)
+	clone.lt = cloneMap(q.lt)
+	clone.lte = cloneMap(q.lte)
+	clone.gt = cloneMap(q.gt)
+	clone.gte = cloneMap(q.gte)
+	clone.match = cloneMap(q.match)
+	clone.near = cloneMap(q.near)
+	clone.within = cloneMap(q.within)
+	clone.order = append([]string{}, q.order...)
+	clone.params = cloneMap(q.params)
+
+	return &clone
+}
+
+func cloneMap[V any](m map[string]V) map[string]V {
+	clone := make(map[string]V, len(m))
+	for k, v := range m {
+		clone[k] = v
+	}
+
+	return clone
+}
+
 // Include query
 func (q *Query) Include(include uint16) *Query {
 	q.include = include
@@ -81,14 +119,23 @@ func (q *Query) Select(fields []string) *Query {
 	return q
 }
 
-// Equal equality query
+// Equal equality query, values of other types than strings, bools, numbers and
+// time.Time are recorded as an error returned by Validate
 func (q *Query) Equal(field string, value interface{}) *Query {
+	if _, err := formatEqualValue(value); q.setValueErr(field, err) {
+		return q
+	}
+
 	q.e[field] = value
 	return q
 }
 
-// NotEqual [ne] query
+// NotEqual [ne] query, takes the same values as Equal
 func (q *Query) NotEqual(field string, value interface{}) *Query {
+	if _, err := formatEqualValue(value); q.setValueErr(field, err) {
+		return q
+	}
+
 	q.ne[field] = value
 	return q
 }
@@ -123,26 +170,43 @@ func (q *Query) NotExists(field string) *Query {
 	return q
 }
 
-// LessThan [lt] query
+// LessThan [lt] query, values which are no strings, numbers or time.Time are
+// recorded as an error returned by Validate
 func (q *Query) LessThan(field string, value interface{}) *Query {
+	if _, err := formatRangeValue(value); q.setValueErr(field, err) {
+		return q
+	}
+
 	q.lt[field] = value
 	return q
 }
 
-// LessThanOrEqual [lte] query
+// LessThanOrEqual [lte] query, takes the same values as LessThan
 func (q *Query) LessThanOrEqual(field string, value interface{}) *Query {
+	if _, err := formatRangeValue(value); q.setValueErr(field, err) {
+		return q
+	}
+
 	q.lte[field] = value
 	return q
 }
 
-// GreaterThan [gt] query
+// GreaterThan [gt] query, takes the same values as LessThan
 func (q *Query) GreaterThan(field string, value interface{}) *Query {
+	if _, err := formatRangeValue(value); q.setValueErr(field, err) {
+		return q
+	}
+
 	q.gt[field] = value
 	return q
 }
 
-// GreaterThanOrEqual [lte] query
+// GreaterThanOrEqual [gte] query, takes the same values as LessThan
 func (q *Query) GreaterThanOrEqual(field string, value interface{}) *Query {
+	if _, err := formatRangeValue(value); q.setValueErr(field, err) {
+		return q
+	}
+
 	q.gte[field] = value
 	return q
 }
@@ -159,24 +223,89 @@ func (q *Query) Match(field, match string) *Query {
 	return q
 }
 
-// Near param
-func (q *Query) Near(field string, lat, lon int16) *Query {
-	q.near[field] = strconv.Itoa(int(lat)) + "," + strconv.Itoa(int(lon))
+// Near param, orders by the distance to the point
+func (q *Query) Near(field string, lat, lon float64) *Query {
+	center := Location{Lat: lat, Lon: lon}
+	if q.setGeoErr(field, center.Validate()) {
+		return q
+	}
+
+	q.near[field] = center.String()
 	return q
 }
 
-// Within param
-func (q *Query) Within(field string, lat1, lon1, lat2, lon2 int16) *Query {
-	q.within[field] = strconv.Itoa(int(lat1)) + "," + strconv.Itoa(int(lon1)) + "," + strconv.Itoa(int(lat2)) + "," + strconv.Itoa(int(lon2))
+// Within param, matches locations in the box between the bottom left and the top right corner
+func (q *Query) Within(field string, lat1, lon1, lat2, lon2 float64) *Query {
+	bottomLeft := Location{Lat: lat1, Lon: lon1}
+	topRight := Location{Lat: lat2, Lon: lon2}
+
+	if q.setGeoErr(field, bottomLeft.Validate()) || q.setGeoErr(field, topRight.Validate()) {
+		return q
+	}
+
+	q.within[field] = bottomLeft.String() + "," + topRight.String()
 	return q
 }
 
-// WithinRadius param
-func (q *Query) WithinRadius(field string, lat1, lon1, radius int16) *Query {
-	q.within[field] = strconv.Itoa(int(lat1)) + "," + strconv.Itoa(int(lon1)) + "," + strconv.Itoa(int(radius))
+// WithinRadius param, matches locations at most radius km away from the point
+func (q *Query) WithinRadius(field string, lat1, lon1, radius float64) *Query {
+	center := Location{Lat: lat1, Lon: lon1}
+	if q.setGeoErr(field, center.Validate()) {
+		return q
+	}
+
+	if !(radius > 0) && q.setGeoErr(field, errors.New("radius should be positive")) {
+		return q
+	}
+
+	q.within[field] = center.String() + "," + strconv.FormatFloat(radius, 'f', -1, 64)
 	return q
 }
 
+// setGeoErr records the first invalid coordinate, returned by Validate
+func (q *Query) setGeoErr(field string, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if q.err == nil {
+		q.err = fmt.Errorf("%s: %w", field, err)
+	}
+
+	return true
+}
+
+// setValueErr records the first invalid value, returned by Validate
+func (q *Query) setValueErr(field string, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if q.err == nil {
+		q.err = fmt.Errorf("%s: %w", field, err)
+	}
+
+	return true
+}
+
+// formatEqualValue formats the value of an equality filter
+func formatEqualValue(value interface{}) (string, error) {
+	if str, ok := value.(string); ok {
+		return str, nil
+	}
+
+	return formatQueryScalar(value)
+}
+
+// formatRangeValue formats the value of a range filter, times are sent without zone
+func formatRangeValue(value interface{}) (string, error) {
+	if t, ok := value.(time.Time); ok {
+		return t.Format("2006-01-02 15:04:05"), nil
+	}
+
+	return formatEqualValue(value)
+}
+
 // Order param
 func (q *Query) Order(field string, reverse bool) *Query {
 	if reverse {
@@ -196,7 +325,7 @@ func (q *Query) Limit(limit uint16) *Query {
 
 // Skip query
 func (q *Query) Skip(skip uint16) *Query {
-	q.skip = skip
+	q.skip = int(skip)
 	return q
 }
 
@@ -212,55 +341,191 @@ func (q *Query) Locale(locale string) *Query {
 	return q
 }
 
-// Values constructs url.Values
-func (q *Query) Values() url.Values {
-	params := url.Values{}
+// LinksToEntry matches entries linking to the entry
+func (q *Query) LinksToEntry(entryID string) *Query {
+	return q.Param("links_to_entry", entryID)
+}
 
-	if q.include != 0 {
-		if q.include > 10 {
-			panic("include value should be between 0 and 10")
-		}
+// LinksToAsset matches entries linking to the asset
+func (q *Query) LinksToAsset(assetID string) *Query {
+	return q.Param("links_to_asset", assetID)
+}
 
-		params.Set("include", strconv.Itoa(int(q.include)))
+// Linked filters on the fields of the entries linked by field. The linked
+// entries have to be of the content type, the filters of linked are applied
+// to them, e.g. Linked("author", "person", NewQuery().Match("fields.name", "Jane")).
+// Paging, ordering and selection of linked are ignored, its errors are recorded
+// on q and returned by Validate.
+func (q *Query) Linked(field, contentTypeID string, linked *Query) *Query {
+	prefix := "fields." + strings.TrimPrefix(field, "fields.") + "."
+
+	if linked.err != nil {
+		q.setValueErr(field, linked.err)
+		return q
 	}
 
-	if q.contentType != "" {
-		params.Set("content_type", q.contentType)
+	q.Param(prefix+"sys.contentType.sys.id", contentTypeID)
+	for key, values := range linked.filterValues() {
+		q.setParam(prefix+key, values...)
+	}
+
+	return q
+}
+
+// TagsIn matches items tagged with any of the tags
+func (q *Query) TagsIn(tagIDs ...string) *Query {
+	return q.Param("metadata.tags.sys.id[in]", strings.Join(tagIDs, ","))
+}
+
+// TagsAll matches items tagged with all of the tags
+func (q *Query) TagsAll(tagIDs ...string) *Query {
+	return q.Param("metadata.tags.sys.id[all]", strings.Join(tagIDs, ","))
+}
+
+// TagsExist matches items with or without tags
+func (q *Query) TagsExist(exist bool) *Query {
+	return q.Param("metadata.tags[exists]", strconv.FormatBool(exist))
+}
+
+// IDs matches items with one of the ids
+func (q *Query) IDs(ids ...string) *Query {
+	return q.Param("sys.id[in]", strings.Join(ids, ","))
+}
+
+// CreatedAfter matches items created after t
+func (q *Query) CreatedAfter(t time.Time) *Query {
+	return q.Param("sys.createdAt[gt]", t.UTC().Format(time.RFC3339))
+}
+
+// CreatedBefore matches items created before t
+func (q *Query) CreatedBefore(t time.Time) *Query {
+	return q.Param("sys.createdAt[lt]", t.UTC().Format(time.RFC3339))
+}
+
+// UpdatedAfter matches items updated after t
+func (q *Query) UpdatedAfter(t time.Time) *Query {
+	return q.Param("sys.updatedAt[gt]", t.UTC().Format(time.RFC3339))
+}
+
+// UpdatedBefore matches items updated before t
+func (q *Query) UpdatedBefore(t time.Time) *Query {
+	return q.Param("sys.updatedAt[lt]", t.UTC().Format(time.RFC3339))
+}
+
+// Published matches published or never published items
+func (q *Query) Published(published bool) *Query {
+	return q.Param("sys.publishedAt[exists]", strconv.FormatBool(published))
+}
+
+// Archived matches archived or not archived items
+func (q *Query) Archived(archived bool) *Query {
+	return q.Param("sys.archivedAt[exists]", strconv.FormatBool(archived))
+}
+
+// Param sets a raw query parameter, the value is sent as is
+func (q *Query) Param(key, value string) *Query {
+	return q.setParam(key, value)
+}
+
+// setParam sets all values of a raw query parameter
+func (q *Query) setParam(key string, values ...string) *Query {
+	if q.params == nil {
+		q.params = make(map[string][]string)
+	}
+
+	q.params[key] = values
+	return q
+}
+
+// Validate returns an error for the values Values would panic on
+func (q *Query) Validate() error {
+	if q.err != nil {
+		return q.err
+	}
+
+	if q.include > 10 {
+		return errors.New("include value should be between 0 and 10")
 	}
 
 	if len(q.fields) > 0 {
 		if len(q.fields) > 100 {
-			panic("You can select up to 100 properties for `select`")
+			return errors.New("You can select up to 100 properties for `select`")
 		}
 
 		for _, sel := range q.fields {
 			if len(strings.Split(sel, ".")) > 2 {
-				panic("you should provide at most 2 depth for `select`")
+				return errors.New("you should provide at most 2 depth for `select`")
 			}
 		}
 
 		if q.contentType == "" {
-			panic("you should provide content_type parameter")
+			return errors.New("you should provide content_type parameter")
 		}
+	}
+
+	if q.limit > 1000 {
+		return errors.New("limit value should be between 0 and 1000")
+	}
+
+	return nil
+}
+
+// Values constructs url.Values, it panics if the query is invalid, see Validate
+func (q *Query) Values() url.Values {
+	values, err := q.BuildValues()
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return values
+}
+
+// BuildValues constructs url.Values like Values, an invalid query is returned as error
+func (q *Query) BuildValues() (url.Values, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
 
+	return q.values(), nil
+}
+
+// nonFilterParams are the parameters of a query which do not filter items
+var nonFilterParams = []string{"include", "content_type", "select", "order", "limit", "skip", "mimetype_group", "locale", "query"}
+
+// filterValues returns the filters of the query without paging, ordering and selection
+func (q *Query) filterValues() url.Values {
+	params := q.values()
+	for _, key := range nonFilterParams {
+		params.Del(key)
+	}
+
+	return params
+}
+
+func (q *Query) values() url.Values {
+	params := url.Values{}
+
+	if q.include != 0 {
+		params.Set("include", strconv.Itoa(int(q.include)))
+	}
+
+	if q.contentType != "" {
+		params.Set("content_type", q.contentType)
+	}
+
+	if len(q.fields) > 0 {
 		params.Set("select", strings.Join(q.fields, ","))
 	}
 
 	for k, v := range q.e {
-		switch t := v.(type) {
-		case int:
-			params.Set(k, strconv.Itoa(t))
-		case string:
-			params.Set(k, t)
+		if value, err := formatEqualValue(v); err == nil {
+			params.Set(k, value)
 		}
 	}
 
 	for k, v := range q.ne {
-		switch t := v.(type) {
-		case int:
-			params.Set(k+"[ne]", strconv.Itoa(t))
-		case string:
-			params.Set(k+"[ne]", t)
+		if value, err := formatEqualValue(v); err == nil {
+			params.Set(k+"[ne]", value)
 		}
 	}
 
@@ -284,39 +549,11 @@ func (q *Query) Values() url.Values {
 		params.Set(v+"[exists]", "false")
 	}
 
-	for k, v := range q.lt {
-		switch t := v.(type) {
-		case int:
-			params.Set(k+"[lt]", strconv.Itoa(t))
-		case time.Time:
-			params.Set(k+"[lt]", t.Format("2006-01-02 15:04:05"))
-		}
-	}
-
-	for k, v := range q.lte {
-		switch t := v.(type) {
-		case int:
-			params.Set(k+"[lte]", strconv.Itoa(t))
-		case time.Time:
-			params.Set(k+"[lte]", t.Format("2006-01-02 15:04:05"))
-		}
-	}
-
-	for k, v := range q.gt {
-		switch t := v.(type) {
-		case int:
-			params.Set(k+"[gt]", strconv.Itoa(t))
-		case time.Time:
-			params.Set(k+"[gt]", t.Format("2006-01-02 15:04:05"))
-		}
-	}
-
-	for k, v := range q.gte {
-		switch t := v.(type) {
-		case int:
-			params.Set(k+"[gte]", strconv.Itoa(t))
-		case time.Time:
-			params.Set(k+"[gte]", t.Format("2006-01-02 15:04:05"))
+	for operator, filters := range map[string]map[string]interface{}{"lt": q.lt, "lte": q.lte, "gt": q.gt, "gte": q.gte} {
+		for k, v := range filters {
+			if value, err := formatRangeValue(v); err == nil {
+				params.Set(k+"["+operator+"]", value)
+			}
 		}
 	}
 
@@ -345,15 +582,11 @@ func (q *Query) Values() url.Values {
 	}
 
 	if q.limit != 0 {
-		if q.limit > 1000 {
-			panic("limit value should be between 0 and 1000")
-		}
-
 		params.Set("limit", strconv.Itoa(int(q.limit)))
 	}
 
 	if q.skip != 0 {
-		params.Set("skip", strconv.Itoa(int(q.skip)))
+		params.Set("skip", strconv.Itoa(q.skip))
 	}
 
 	if q.mime != "" {
@@ -364,6 +597,10 @@ func (q *Query) Values() url.Values {
 		params.Set("locale", q.locale)
 	}
 
+	for k, v := range q.params {
+		params[k] = append([]string{}, v...)
+	}
+
 	return params
 }
 
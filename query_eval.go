@@ -0,0 +1,842 @@
+package contentful
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultQueryLimit is the page size of the delivery API when a query has no limit
+const defaultQueryLimit = 100
+
+// queryTimeLayouts are the date formats accepted in query values and documents
+var queryTimeLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// mimeTypeGroups maps the mimetype_group values to the content types they match,
+// content types ending with a slash match by prefix
+var mimeTypeGroups = map[string][]string{
+	"image":        {"image/"},
+	"audio":        {"audio/"},
+	"video":        {"video/"},
+	"plaintext":    {"text/plain"},
+	"pdfdocument":  {"application/pdf"},
+	"markup":       {"text/html", "text/xml", "application/xml", "application/xhtml+xml"},
+	"code":         {"application/json", "application/javascript", "text/javascript", "text/css"},
+	"archive":      {"application/zip", "application/gzip", "application/x-tar", "application/x-7z-compressed", "application/x-rar-compressed"},
+	"richtext":     {"application/rtf", "application/msword", "application/vnd.openxmlformats-officedocument.wordprocessingml.document", "application/vnd.oasis.opendocument.text"},
+	"presentation": {"application/vnd.ms-powerpoint", "application/vnd.openxmlformats-officedocument.presentationml.presentation", "application/vnd.oasis.opendocument.presentation", "application/vnd.apple.keynote"},
+	"spreadsheet":  {"application/vnd.ms-excel", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", "application/vnd.oasis.opendocument.spreadsheet", "application/vnd.apple.numbers", "text/csv"},
+}
+
+// QueryEvaluator evaluates queries against entries and assets held in memory
+// following the documented query semantics of the delivery API, e.g. for
+// offline caches, test doubles or to filter webhook payloads. Results are not
+// verified against the API, edge cases like ordering of missing values or
+// full text matching may differ from it.
+//
+// Items fetched with all locales, like CMA entities and webhook payloads, hold
+// their field values per locale. Items fetched for a single locale have
+// Sys.Locale (or Locale) set and are used as they are.
+type QueryEvaluator struct {
+	// DefaultLocale is used when the query has no locale
+	DefaultLocale string
+	// Fallbacks maps a locale to the locale used for its missing values
+	Fallbacks map[string]string
+}
+
+// NewQueryEvaluator returns an evaluator reading fields in the default locale
+func NewQueryEvaluator(defaultLocale string) *QueryEvaluator {
+	return &QueryEvaluator{DefaultLocale: defaultLocale, Fallbacks: map[string]string{}}
+}
+
+// LocalResult is a page of items matched by a QueryEvaluator
+type LocalResult[T any] struct {
+	Items []T
+	// Total is the number of matching items before skip and limit
+	Total int
+	Skip  int
+	Limit int
+}
+
+// Entries returns the page of entries matching the query. Links between the
+// given entries are resolved for filters on linked entries.
+func (e *QueryEvaluator) Entries(entries []Entry, query *Query) (*LocalResult[Entry], error) {
+	return evaluateQuery(e, entries, query, "locale", func(entry Entry) bool {
+		return entry.Locale != "" || (entry.Sys != nil && entry.Sys.Locale != "")
+	})
+}
+
+// Assets returns the page of assets matching the query
+func (e *QueryEvaluator) Assets(assets []Asset, query *Query) (*LocalResult[Asset], error) {
+	return evaluateQuery(e, assets, query, "Locale", func(asset Asset) bool {
+		return asset.Locale != "" || (asset.Sys != nil && asset.Sys.Locale != "")
+	})
+}
+
+// MatchEntry reports whether the entry matches the filters of the query
+func (e *QueryEvaluator) MatchEntry(entry Entry, query *Query) (bool, error) {
+	if query == nil {
+		query = NewQuery()
+	}
+
+	result, err := e.Entries([]Entry{entry}, query.Clone().Skip(0))
+	if err != nil {
+		return false, err
+	}
+
+	return result.Total == 1, nil
+}
+
+// MatchAsset reports whether the asset matches the filters of the query
+func (e *QueryEvaluator) MatchAsset(asset Asset, query *Query) (bool, error) {
+	if query == nil {
+		query = NewQuery()
+	}
+
+	result, err := e.Assets([]Asset{asset}, query.Clone().Skip(0))
+	if err != nil {
+		return false, err
+	}
+
+	return result.Total == 1, nil
+}
+
+// evalDocument is an item converted to its JSON document
+type evalDocument struct {
+	original map[string]any
+	// view holds the fields in the locale the query is evaluated in
+	view map[string]any
+	flat bool
+}
+
+// queryFilter matches a document
+type queryFilter func(doc map[string]any) bool
+
+// evaluator holds the state of a single evaluation
+type evaluator struct {
+	*QueryEvaluator
+	locale string
+	links  map[string]map[string]any
+}
+
+func evaluateQuery[T any](e *QueryEvaluator, items []T, query *Query, localeKey string, flat func(item T) bool) (*LocalResult[T], error) {
+	if query == nil {
+		query = NewQuery()
+	}
+
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+	params := query.values()
+
+	locale := params.Get("locale")
+	ev := &evaluator{QueryEvaluator: e, locale: locale, links: map[string]map[string]any{}}
+	if locale == "" || locale == "*" {
+		ev.locale = e.DefaultLocale
+	}
+
+	docs := make([]*evalDocument, 0, len(items))
+	for _, item := range items {
+		doc, err := toDocument(item)
+		if err != nil {
+			return nil, err
+		}
+
+		d := &evalDocument{original: doc, flat: flat(item)}
+		d.view = ev.localize(doc, d.flat)
+		docs = append(docs, d)
+
+		if id, typ := lookupString(doc, "sys.id"), lookupString(doc, "sys.type"); id != "" {
+			ev.links[typ+":"+id] = d.view
+		}
+	}
+
+	filters, err := ev.filters(params)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := []*evalDocument{}
+	for _, doc := range docs {
+		ok := true
+		for _, filter := range filters {
+			if !filter(doc.view) {
+				ok = false
+				break
+			}
+		}
+
+		if ok {
+			matched = append(matched, doc)
+		}
+	}
+
+	if err := ev.sort(matched, params); err != nil {
+		return nil, err
+	}
+
+	result := &LocalResult[T]{Total: len(matched), Limit: defaultQueryLimit}
+	if limit := params.Get("limit"); limit != "" {
+		result.Limit, _ = strconv.Atoi(limit)
+	}
+	if skip := params.Get("skip"); skip != "" {
+		result.Skip, _ = strconv.Atoi(skip)
+	}
+
+	start := minInt(result.Skip, len(matched))
+	end := minInt(start+result.Limit, len(matched))
+
+	var selected []string
+	if sel := params.Get("select"); sel != "" {
+		selected = strings.Split(sel, ",")
+	}
+
+	result.Items = make([]T, 0, end-start)
+	for _, doc := range matched[start:end] {
+		out := doc.original
+		if locale != "*" && !doc.flat {
+			out = ev.localize(doc.original, false)
+			out[localeKey] = ev.locale
+		}
+
+		out = project(out, selected)
+
+		data, err := json.Marshal(out)
+		if err != nil {
+			return nil, err
+		}
+
+		var item T
+		if err := json.Unmarshal(data, &item); err != nil {
+			return nil, err
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	return result, nil
+}
+
+// localize returns a copy of the document with every field value in the locale of the evaluation
+func (ev *evaluator) localize(doc map[string]any, flat bool) map[string]any {
+	fields, ok := doc["fields"].(map[string]any)
+	if flat || !ok {
+		return doc
+	}
+
+	localized := make(map[string]any, len(doc))
+	for k, v := range doc {
+		localized[k] = v
+	}
+
+	localizedFields := make(map[string]any, len(fields))
+	for name, value := range fields {
+		values, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for locale, seen := ev.locale, map[string]bool{}; locale != "" && !seen[locale]; locale = ev.Fallbacks[locale] {
+			seen[locale] = true
+			if v, ok := values[locale]; ok {
+				localizedFields[name] = v
+				break
+			}
+		}
+	}
+	localized["fields"] = localizedFields
+
+	return localized
+}
+
+// filters builds the filters of the query parameters
+func (ev *evaluator) filters(params url.Values) ([]queryFilter, error) {
+	var filters []queryFilter
+	var unsupported []string
+
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := params.Get(key)
+
+		switch key {
+		case "include", "select", "order", "limit", "skip", "locale":
+			continue
+		case "content_type":
+			filters = append(filters, ev.compare("sys.contentType.sys.id", func(c any) bool { return queryEqual(c, value) }))
+			continue
+		case "query":
+			terms := strings.Fields(strings.ToLower(value))
+			filters = append(filters, func(doc map[string]any) bool {
+				return containsTerms(strings.ToLower(strings.Join(texts(doc["fields"]), " ")), terms)
+			})
+			continue
+		case "links_to_entry", "links_to_asset":
+			linkType := "Entry"
+			if key == "links_to_asset" {
+				linkType = "Asset"
+			}
+			filters = append(filters, func(doc map[string]any) bool {
+				return linksTo(doc["fields"], linkType, value)
+			})
+			continue
+		case "mimetype_group":
+			group, ok := mimeTypeGroups[value]
+			if !ok && value != "attachment" {
+				return nil, fmt.Errorf("unknown mimetype_group %s", value)
+			}
+			filters = append(filters, ev.compare("fields.file.contentType", func(c any) bool {
+				return value == "attachment" || matchesMimeGroup(fmt.Sprint(c), group)
+			}))
+			continue
+		}
+
+		field, operator := key, ""
+		if i := strings.LastIndex(key, "["); i > 0 && strings.HasSuffix(key, "]") {
+			field, operator = key[:i], key[i+1:len(key)-1]
+		}
+
+		if !isFilterPath(field) {
+			unsupported = append(unsupported, key)
+			continue
+		}
+
+		filter, err := ev.operatorFilter(field, operator, value)
+		if err != nil {
+			return nil, err
+		}
+
+		if filter == nil {
+			unsupported = append(unsupported, key)
+			continue
+		}
+
+		filters = append(filters, filter)
+	}
+
+	if len(unsupported) > 0 {
+		return nil, UnsupportedQueryParamsError{Params: unsupported}
+	}
+
+	return filters, nil
+}
+
+// operatorFilter returns the filter of a field operator, nil for unknown operators
+func (ev *evaluator) operatorFilter(field, operator, value string) (queryFilter, error) {
+	switch operator {
+	case "":
+		return ev.compare(field, func(c any) bool { return queryEqual(c, value) }), nil
+	case "ne":
+		match := ev.compare(field, func(c any) bool { return queryEqual(c, value) })
+		return func(doc map[string]any) bool { return !match(doc) }, nil
+	case "in":
+		set := strings.Split(value, ",")
+		return ev.compare(field, func(c any) bool { return queryEqualAny(c, set) }), nil
+	case "nin":
+		set := strings.Split(value, ",")
+		match := ev.compare(field, func(c any) bool { return queryEqualAny(c, set) })
+		return func(doc map[string]any) bool { return !match(doc) }, nil
+	case "all":
+		set := strings.Split(value, ",")
+		return func(doc map[string]any) bool {
+			candidates := ev.lookup(doc, field)
+			for _, want := range set {
+				found := false
+				for _, c := range candidates {
+					if queryEqual(c, want) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+			return true
+		}, nil
+	case "exists":
+		exists, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("query parameter %s[exists] should be true or false", field)
+		}
+		return func(doc map[string]any) bool { return (len(ev.lookup(doc, field)) > 0) == exists }, nil
+	case "lt", "lte", "gt", "gte":
+		return ev.compare(field, func(c any) bool {
+			cmp, ok := queryCompare(c, value)
+			if !ok {
+				return false
+			}
+			switch operator {
+			case "lt":
+				return cmp < 0
+			case "lte":
+				return cmp <= 0
+			case "gt":
+				return cmp > 0
+			}
+			return cmp >= 0
+		}), nil
+	case "match":
+		terms := strings.Fields(strings.ToLower(value))
+		return ev.compare(field, func(c any) bool {
+			return containsTerms(strings.ToLower(strings.Join(texts(c), " ")), terms)
+		}), nil
+	case "near":
+		if _, err := parseLocation(value, 2); err != nil {
+			return nil, err
+		}
+		// near orders the items, see sort
+		return func(doc map[string]any) bool { return len(ev.lookup(doc, field)) > 0 }, nil
+	case "within":
+		return ev.withinFilter(field, value)
+	}
+
+	return nil, nil
+}
+
+// withinFilter matches locations in a bounding box or circle
+func (ev *evaluator) withinFilter(field, value string) (queryFilter, error) {
+	numbers, err := parseLocation(value, 3, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	return ev.compare(field, func(c any) bool {
+		location, err := LocationFromValue(c)
+		if err != nil {
+			return false
+		}
+
+		if len(numbers) == 3 {
+			return Location{Lat: numbers[0], Lon: numbers[1]}.DistanceTo(location) <= numbers[2]
+		}
+
+		inLat := location.Lat >= numbers[0] && location.Lat <= numbers[2]
+		if numbers[1] <= numbers[3] {
+			return inLat && location.Lon >= numbers[1] && location.Lon <= numbers[3]
+		}
+
+		// the box crosses the antimeridian
+		return inLat && (location.Lon >= numbers[1] || location.Lon <= numbers[3])
+	}), nil
+}
+
+// compare returns a filter matching documents where any value of the field matches
+func (ev *evaluator) compare(field string, match func(candidate any) bool) queryFilter {
+	return func(doc map[string]any) bool {
+		for _, candidate := range ev.lookup(doc, field) {
+			if match(candidate) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// lookup returns the values of the path, arrays are flattened and links to
+// known items are resolved once
+func (ev *evaluator) lookup(doc map[string]any, path string) []any {
+	return ev.lookupSegments(doc, strings.Split(path, "."), true)
+}
+
+func (ev *evaluator) lookupSegments(value any, segments []string, resolve bool) []any {
+	if len(segments) == 0 {
+		switch v := value.(type) {
+		case nil:
+			return nil
+		case []any:
+			return v
+		}
+		return []any{value}
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		if resolve && isLink(v) {
+			if target, ok := ev.links[lookupString(v, "sys.linkType")+":"+lookupString(v, "sys.id")]; ok && !(len(segments) == 2 && segments[0] == "sys" && segments[1] == "id") {
+				return ev.lookupSegments(target, segments, false)
+			}
+		}
+
+		child, ok := v[segments[0]]
+		if !ok {
+			return nil
+		}
+		return ev.lookupSegments(child, segments[1:], resolve)
+	case []any:
+		var values []any
+		for _, item := range v {
+			values = append(values, ev.lookupSegments(item, segments, resolve)...)
+		}
+		return values
+	}
+
+	return nil
+}
+
+// sort orders the documents by the order parameter or the distance of a near filter
+func (ev *evaluator) sort(docs []*evalDocument, params url.Values) error {
+	for key, values := range params {
+		if !strings.HasSuffix(key, "[near]") {
+			continue
+		}
+
+		numbers, err := parseLocation(values[0], 2)
+		if err != nil {
+			return err
+		}
+
+		origin := Location{Lat: numbers[0], Lon: numbers[1]}
+		field := strings.TrimSuffix(key, "[near]")
+		distance := func(doc *evalDocument) float64 {
+			for _, c := range ev.lookup(doc.view, field) {
+				if location, err := LocationFromValue(c); err == nil {
+					return origin.DistanceTo(location)
+				}
+			}
+			return math.Inf(1)
+		}
+
+		sort.SliceStable(docs, func(i, j int) bool { return distance(docs[i]) < distance(docs[j]) })
+
+		return nil
+	}
+
+	order := params.Get("order")
+	if order == "" {
+		return nil
+	}
+
+	fields := strings.Split(order, ",")
+	sort.SliceStable(docs, func(i, j int) bool {
+		for _, field := range fields {
+			reverse := strings.HasPrefix(field, "-")
+			path := strings.TrimPrefix(field, "-")
+
+			a := firstValue(ev.lookup(docs[i].view, path))
+			b := firstValue(ev.lookup(docs[j].view, path))
+
+			// items without a value come last in both directions
+			switch {
+			case a == nil && b == nil:
+				continue
+			case a == nil:
+				return false
+			case b == nil:
+				return true
+			}
+
+			cmp := orderCompare(a, b)
+			if cmp == 0 {
+				continue
+			}
+
+			if reverse {
+				return cmp > 0
+			}
+			return cmp < 0
+		}
+
+		return false
+	})
+
+	return nil
+}
+
+// project keeps sys and the selected properties of the document
+func project(doc map[string]any, selected []string) map[string]any {
+	if len(selected) == 0 {
+		return doc
+	}
+
+	out := map[string]any{}
+	fields, _ := doc["fields"].(map[string]any)
+
+	for k, v := range doc {
+		if _, ok := v.(map[string]any); !ok && k != "fields" && k != "metadata" {
+			out[k] = v
+		}
+	}
+	out["sys"] = doc["sys"]
+
+	for _, sel := range selected {
+		switch {
+		case sel == "fields" || sel == "metadata":
+			out[sel] = doc[sel]
+		case strings.HasPrefix(sel, "fields."):
+			name := strings.SplitN(strings.TrimPrefix(sel, "fields."), ".", 2)[0]
+			selectedFields, ok := out["fields"].(map[string]any)
+			if !ok {
+				selectedFields = map[string]any{}
+				out["fields"] = selectedFields
+			}
+			if value, ok := fields[name]; ok {
+				selectedFields[name] = value
+			}
+		}
+	}
+
+	return out
+}
+
+// queryEqual compares a document value with a query value
+func queryEqual(candidate any, want string) bool {
+	switch c := candidate.(type) {
+	case string:
+		if c == want {
+			return true
+		}
+		a, okA := parseQueryTime(c)
+		b, okB := parseQueryTime(want)
+		return okA && okB && a.Equal(b)
+	case float64:
+		w, err := strconv.ParseFloat(want, 64)
+		return err == nil && c == w
+	case bool:
+		w, err := strconv.ParseBool(want)
+		return err == nil && c == w
+	}
+
+	return false
+}
+
+func queryEqualAny(candidate any, set []string) bool {
+	for _, want := range set {
+		if queryEqual(candidate, want) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// queryCompare compares numbers and dates, ok is false for other values
+func queryCompare(candidate any, want string) (int, bool) {
+	switch c := candidate.(type) {
+	case float64:
+		w, err := strconv.ParseFloat(want, 64)
+		if err != nil {
+			return 0, false
+		}
+		return compareFloat(c, w), true
+	case string:
+		a, okA := parseQueryTime(c)
+		b, okB := parseQueryTime(want)
+		if !okA || !okB {
+			return 0, false
+		}
+		return compareTime(a, b), true
+	}
+
+	return 0, false
+}
+
+// orderCompare compares two document values for ordering
+func orderCompare(a, b any) int {
+	switch x := a.(type) {
+	case float64:
+		if y, ok := b.(float64); ok {
+			return compareFloat(x, y)
+		}
+	case bool:
+		if y, ok := b.(bool); ok {
+			switch {
+			case x == y:
+				return 0
+			case !x:
+				return -1
+			}
+			return 1
+		}
+	case string:
+		if y, ok := b.(string); ok {
+			if tx, ok := parseQueryTime(x); ok {
+				if ty, ok := parseQueryTime(y); ok {
+					return compareTime(tx, ty)
+				}
+			}
+			return strings.Compare(x, y)
+		}
+	}
+
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+func compareTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+
+	return 0
+}
+
+func parseQueryTime(value string) (time.Time, bool) {
+	for _, layout := range queryTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// parseLocation parses the comma separated numbers of near and within values
+func parseLocation(value string, counts ...int) ([]float64, error) {
+	parts := strings.Split(value, ",")
+
+	valid := false
+	for _, count := range counts {
+		valid = valid || len(parts) == count
+	}
+	if !valid {
+		return nil, fmt.Errorf("invalid coordinates %q", value)
+	}
+
+	numbers := make([]float64, 0, len(parts))
+	for _, part := range parts {
+		number, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid coordinates %q", value)
+		}
+		numbers = append(numbers, number)
+	}
+
+	return numbers, nil
+}
+
+// texts returns the strings of a value, rich text documents included
+func texts(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var out []string
+		for _, item := range v {
+			out = append(out, texts(item)...)
+		}
+		return out
+	case map[string]any:
+		if isLink(v) {
+			return nil
+		}
+		if nodeType, ok := v["nodeType"]; ok {
+			if nodeType == "text" {
+				return texts(v["value"])
+			}
+			return texts(v["content"])
+		}
+		var out []string
+		for _, item := range v {
+			out = append(out, texts(item)...)
+		}
+		return out
+	}
+
+	return nil
+}
+
+func containsTerms(text string, terms []string) bool {
+	for _, term := range terms {
+		if !strings.Contains(text, term) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// linksTo reports whether the value contains a link to the entity
+func linksTo(value any, linkType, id string) bool {
+	switch v := value.(type) {
+	case []any:
+		for _, item := range v {
+			if linksTo(item, linkType, id) {
+				return true
+			}
+		}
+	case map[string]any:
+		if isLink(v) {
+			return lookupString(v, "sys.linkType") == linkType && lookupString(v, "sys.id") == id
+		}
+		for _, item := range v {
+			if linksTo(item, linkType, id) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func matchesMimeGroup(contentType string, group []string) bool {
+	for _, t := range group {
+		if contentType == t || (strings.HasSuffix(t, "/") && strings.HasPrefix(contentType, t)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isLink(v map[string]any) bool {
+	return lookupString(v, "sys.type") == "Link"
+}
+
+func lookupString(doc map[string]any, path string) string {
+	var value any = doc
+	for _, segment := range strings.Split(path, ".") {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return ""
+		}
+		value = m[segment]
+	}
+
+	s, _ := value.(string)
+	return s
+}
+
+func firstValue(values []any) any {
+	if len(values) == 0 {
+		return nil
+	}
+
+	return values[0]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
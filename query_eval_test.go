@@ -0,0 +1,162 @@
+package contentful
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// queryConformance holds the cases in testdata/query_conformance.json. The
+// cases are hand-written from the delivery API documentation, not recorded from
+// the API. Each case holds the ids the query is intended to match on the entries
+// of the file, the local evaluator has to return them in the same order.
+type queryConformance struct {
+	Description string  `json:"description"`
+	Entries     []Entry `json:"entries"`
+	Cases       []struct {
+		Name  string   `json:"name"`
+		Query string   `json:"query"`
+		IDs   []string `json:"ids"`
+	} `json:"cases"`
+}
+
+func entryIDs(entries []Entry) []string {
+	ids := []string{}
+	for _, entry := range entries {
+		ids = append(ids, entry.Sys.ID)
+	}
+
+	return ids
+}
+
+func TestQueryEvaluator_Conformance(t *testing.T) {
+	assertions := assert.New(t)
+
+	var conformance queryConformance
+	err := json.NewDecoder(strings.NewReader(readTestData("query_conformance.json"))).Decode(&conformance)
+	assertions.Nil(err)
+
+	evaluator := NewQueryEvaluator("en-US")
+	evaluator.Fallbacks["de-DE"] = "en-US"
+
+	for _, c := range conformance.Cases {
+		query, err := ParseQueryString(c.Query)
+		assertions.Nil(err, c.Name)
+
+		result, err := evaluator.Entries(conformance.Entries, query)
+		assertions.Nil(err, c.Name)
+		assertions.Equal(c.IDs, entryIDs(result.Items), c.Name)
+	}
+}
+
+func TestQueryEvaluator_Entries(t *testing.T) {
+	assertions := assert.New(t)
+
+	var conformance queryConformance
+	err := json.NewDecoder(strings.NewReader(readTestData("query_conformance.json"))).Decode(&conformance)
+	assertions.Nil(err)
+
+	evaluator := NewQueryEvaluator("en-US")
+
+	result, err := evaluator.Entries(conformance.Entries, NewQuery().ContentType("product").Order("sys.createdAt", false).Limit(1).Skip(1))
+	assertions.Nil(err)
+	assertions.Equal(4, result.Total)
+	assertions.Equal(1, result.Skip)
+	assertions.Equal(1, result.Limit)
+	assertions.Equal("en-US", result.Items[0].Locale)
+	assertions.Equal("Trail Shoe", result.Items[0].Fields["title"])
+
+	result, err = evaluator.Entries(conformance.Entries, NewQuery().ContentType("product").Select([]string{"fields.title"}).Limit(1))
+	assertions.Nil(err)
+	assertions.Equal(map[string]any{"title": "Running Shoe"}, result.Items[0].Fields)
+	assertions.Equal("p1", result.Items[0].Sys.ID)
+
+	result, err = evaluator.Entries(conformance.Entries, NewQuery().Locale("*").Equal("sys.id", "p1"))
+	assertions.Nil(err)
+	assertions.Equal(map[string]any{"en-US": "Running Shoe", "de-DE": "Laufschuh"}, result.Items[0].Fields["title"])
+
+	result, err = evaluator.Entries(conformance.Entries, nil)
+	assertions.Nil(err)
+	assertions.Equal(6, result.Total)
+	assertions.Equal(100, result.Limit)
+
+	_, err = evaluator.Entries(conformance.Entries, NewQuery().Param("fields.title[regex]", "S.*"))
+	var unsupported UnsupportedQueryParamsError
+	assertions.True(errors.As(err, &unsupported))
+	assertions.Equal([]string{"fields.title[regex]"}, unsupported.Params)
+}
+
+func TestQueryEvaluator_SingleLocaleEntries(t *testing.T) {
+	assertions := assert.New(t)
+
+	entries := []Entry{
+		{Sys: &Sys{ID: "a", Locale: "en-US"}, Fields: map[string]any{"title": "Alpha", "rating": float64(3)}},
+		{Sys: &Sys{ID: "b", Locale: "en-US"}, Fields: map[string]any{"title": "Beta", "rating": float64(5)}},
+	}
+
+	result, err := NewQueryEvaluator("en-US").Entries(entries, NewQuery().GreaterThan("fields.rating", 4))
+	assertions.Nil(err)
+	assertions.Equal([]string{"b"}, entryIDs(result.Items))
+	assertions.Equal("Beta", result.Items[0].Fields["title"])
+}
+
+func TestQueryEvaluator_MatchEntry(t *testing.T) {
+	assertions := assert.New(t)
+
+	// a webhook payload with all locales
+	var entry Entry
+	err := json.Unmarshal([]byte(`{
+		"sys": {"id": "p1", "type": "Entry", "contentType": {"sys": {"type": "Link", "linkType": "ContentType", "id": "product"}}},
+		"fields": {"title": {"en-US": "Running Shoe"}, "price": {"en-US": 89.99}}
+	}`), &entry)
+	assertions.Nil(err)
+
+	evaluator := NewQueryEvaluator("en-US")
+
+	ok, err := evaluator.MatchEntry(entry, NewQuery().ContentType("product").LessThan("fields.price", 100).Skip(10))
+	assertions.Nil(err)
+	assertions.True(ok)
+
+	ok, err = evaluator.MatchEntry(entry, NewQuery().Match("fields.title", "sandal"))
+	assertions.Nil(err)
+	assertions.False(ok)
+
+	ok, err = evaluator.MatchEntry(entry, nil)
+	assertions.Nil(err)
+	assertions.True(ok)
+}
+
+func TestQueryEvaluator_Assets(t *testing.T) {
+	assertions := assert.New(t)
+
+	var assets []Asset
+	err := json.Unmarshal([]byte(`[
+		{"sys": {"id": "photo", "type": "Asset"}, "fields": {"title": {"en-US": "Photo"}, "file": {"en-US": {"url": "//images/photo.jpg", "contentType": "image/jpeg", "fileName": "photo.jpg"}}}},
+		{"sys": {"id": "manual", "type": "Asset"}, "fields": {"title": {"en-US": "Manual"}, "file": {"en-US": {"url": "//assets/manual.pdf", "contentType": "application/pdf", "fileName": "manual.pdf"}}}},
+		{"sys": {"id": "logo", "type": "Asset"}, "fields": {"title": {"en-US": "Logo"}, "file": {"en-US": {"url": "//images/logo.png", "contentType": "image/png", "fileName": "logo.png"}}}}
+	]`), &assets)
+	assertions.Nil(err)
+
+	evaluator := NewQueryEvaluator("en-US")
+
+	result, err := evaluator.Assets(assets, NewQuery().MimeType("image").Order("fields.title", false))
+	assertions.Nil(err)
+	assertions.Equal(2, result.Total)
+	assertions.Equal("logo", result.Items[0].Sys.ID)
+	assertions.Equal("Logo", *result.Items[0].Fields.Title.Item)
+	assertions.Equal("en-US", result.Items[0].Locale)
+
+	result, err = evaluator.Assets(assets, NewQuery().MimeType("pdfdocument"))
+	assertions.Nil(err)
+	assertions.Equal("manual", result.Items[0].Sys.ID)
+
+	ok, err := evaluator.MatchAsset(assets[0], NewQuery().Equal("fields.file.fileName", "photo.jpg"))
+	assertions.Nil(err)
+	assertions.True(ok)
+
+	_, err = evaluator.Assets(assets, NewQuery().MimeType("hologram"))
+	assertions.NotNil(err)
+}
@@ -0,0 +1,209 @@
+package contentful
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// filterPrefixes are the paths plain equality filters may start with
+var filterPrefixes = []string{"fields.", "sys.", "metadata."}
+
+// ParseQueryString parses an encoded query string, see ParseQuery
+func ParseQueryString(query string) (*Query, error) {
+	values, err := url.ParseQuery(strings.TrimPrefix(query, "?"))
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseQuery(values)
+}
+
+// ParseQuery rebuilds a query from the parameters produced by Query.Values.
+// Values are kept as strings, so the parsed query encodes to the same
+// parameters. Invalid values return an error. Parameters the query can not
+// express are skipped and reported by an UnsupportedQueryParamsError, the
+// returned query holds the other parameters.
+func ParseQuery(values url.Values) (*Query, error) {
+	q := NewQuery()
+
+	var unsupported []string
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if len(values[key]) != 1 {
+			return nil, fmt.Errorf("query parameter %s is repeated", key)
+		}
+
+		ok, err := q.parseParam(key, values[key][0])
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			unsupported = append(unsupported, key)
+		}
+	}
+
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	if len(unsupported) > 0 {
+		return q, UnsupportedQueryParamsError{Params: unsupported}
+	}
+
+	return q, nil
+}
+
+// parseParam applies a single parameter, it returns false for unsupported parameters
+func (q *Query) parseParam(key, value string) (bool, error) {
+	switch key {
+	case "include":
+		include, err := parseQueryUint(key, value)
+		q.Include(include)
+		return true, err
+	case "limit":
+		limit, err := parseQueryUint(key, value)
+		q.Limit(limit)
+		return true, err
+	case "skip":
+		// the API allows offsets beyond the uint16 of Query.Skip
+		skip, err := strconv.Atoi(value)
+		if err != nil || skip < 0 {
+			return true, fmt.Errorf("query parameter %s should be a positive number", key)
+		}
+		q.skip = skip
+		return true, nil
+	case "content_type":
+		q.ContentType(value)
+		return true, nil
+	case "select":
+		q.Select(strings.Split(value, ","))
+		return true, nil
+	case "order":
+		for _, field := range strings.Split(value, ",") {
+			q.Order(strings.TrimPrefix(field, "-"), strings.HasPrefix(field, "-"))
+		}
+		return true, nil
+	case "query":
+		q.Query(value)
+		return true, nil
+	case "mimetype_group":
+		q.MimeType(value)
+		return true, nil
+	case "locale":
+		q.Locale(value)
+		return true, nil
+	case "links_to_entry", "links_to_asset":
+		q.Param(key, value)
+		return true, nil
+	}
+
+	field, operator := key, ""
+	if i := strings.LastIndex(key, "["); i > 0 && strings.HasSuffix(key, "]") {
+		field, operator = key[:i], key[i+1:len(key)-1]
+	}
+
+	if !isFilterPath(field) {
+		return false, nil
+	}
+
+	switch operator {
+	case "":
+		q.Equal(field, value)
+	case "ne":
+		q.NotEqual(field, value)
+	case "all":
+		q.All(field, strings.Split(value, ","))
+	case "in":
+		q.In(field, strings.Split(value, ","))
+	case "nin":
+		q.NotIn(field, strings.Split(value, ","))
+	case "exists":
+		exists, err := strconv.ParseBool(value)
+		if err != nil {
+			return true, fmt.Errorf("query parameter %s should be true or false", key)
+		}
+		if exists {
+			q.Exists(field)
+		} else {
+			q.NotExists(field)
+		}
+	case "lt":
+		q.LessThan(field, value)
+	case "lte":
+		q.LessThanOrEqual(field, value)
+	case "gt":
+		q.GreaterThan(field, value)
+	case "gte":
+		q.GreaterThanOrEqual(field, value)
+	case "match":
+		q.Match(field, value)
+	case "near", "within":
+		return true, q.parseGeo(key, field, operator, value)
+	default:
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// parseGeo applies near and within filters, validating the coordinates
+func (q *Query) parseGeo(key, field, operator, value string) error {
+	parts := strings.Split(value, ",")
+	numbers := make([]float64, 0, len(parts))
+	for _, part := range parts {
+		number, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return fmt.Errorf("query parameter %s should be a list of numbers", key)
+		}
+		numbers = append(numbers, number)
+	}
+
+	switch {
+	case operator == "near" && len(numbers) == 2:
+		q.Near(field, numbers[0], numbers[1])
+	case operator == "within" && len(numbers) == 3:
+		q.WithinRadius(field, numbers[0], numbers[1], numbers[2])
+	case operator == "within" && len(numbers) == 4:
+		q.Within(field, numbers[0], numbers[1], numbers[2], numbers[3])
+	default:
+		return fmt.Errorf("query parameter %s has %d coordinates", key, len(numbers))
+	}
+
+	// keep the values as sent, Near and Within normalize number formatting
+	if operator == "near" {
+		q.near[field] = value
+	} else {
+		q.within[field] = value
+	}
+
+	return nil
+}
+
+func parseQueryUint(key, value string) (uint16, error) {
+	number, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("query parameter %s should be a positive number", key)
+	}
+
+	return uint16(number), nil
+}
+
+func isFilterPath(field string) bool {
+	for _, prefix := range filterPrefixes {
+		if strings.HasPrefix(field, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,104 @@
+package contentful
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuery_RoundTrip(t *testing.T) {
+	assertions := assert.New(t)
+
+	person := NewQuery().Match("fields.name", "Jane")
+	created := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	queries := []*Query{
+		NewQuery(),
+		NewQuery().Include(3).ContentType("product").Select([]string{"sys", "fields.title"}),
+		NewQuery().Equal("fields.title", "Shoe").NotEqual("fields.stock", 0).Equal("fields.available", true),
+		NewQuery().All("fields.tags", []string{"a", "b"}).In("sys.id", []string{"1", "2"}).NotIn("sys.id", []string{"3"}),
+		NewQuery().Exists("fields.image").NotExists("fields.video"),
+		NewQuery().LessThan("fields.stock", 10).LessThanOrEqual("fields.price", 9.99).GreaterThan("sys.createdAt", created).GreaterThanOrEqual("fields.rating", 4),
+		NewQuery().Query("running shoes").Match("fields.body", "sole"),
+		NewQuery().Near("fields.store", 52.52, 13.405),
+		NewQuery().Within("fields.store", 52.3, 13.1, 52.7, 13.7),
+		NewQuery().WithinRadius("fields.store", 52.52, 13.405, 2.5),
+		NewQuery().Order("sys.createdAt", true).Order("fields.title", false).Limit(50).Skip(100),
+		NewQuery().MimeType("image").Locale("de-DE"),
+		NewQuery().LinksToEntry("entry_id"),
+		NewQuery().LinksToAsset("asset_id"),
+		NewQuery().ContentType("post").Linked("author", "person", person),
+		NewQuery().TagsIn("sale", "new").TagsAll("shoes").TagsExist(true),
+		NewQuery().IDs("a", "b").CreatedAfter(created).UpdatedBefore(created).Published(true).Archived(false),
+	}
+
+	for _, q := range queries {
+		parsed, err := ParseQuery(q.Values())
+		assertions.Nil(err, q.String())
+		assertions.Equal(q.String(), parsed.String())
+
+		parsed, err = ParseQueryString(q.String())
+		assertions.Nil(err, q.String())
+		assertions.Equal(q.String(), parsed.String())
+	}
+}
+
+func TestParseQueryString(t *testing.T) {
+	assertions := assert.New(t)
+
+	q, err := ParseQueryString("?content_type=product&fields.price[lt]=20&order=-sys.updatedAt&include=2")
+	assertions.Nil(err)
+
+	expected := url.Values{}
+	expected.Set("content_type", "product")
+	expected.Set("fields.price[lt]", "20")
+	expected.Set("order", "-sys.updatedAt")
+	expected.Set("include", "2")
+	assertions.Equal(expected, q.Values())
+
+	// skip is not capped like limit and include
+	q, err = ParseQueryString("skip=70000")
+	assertions.Nil(err)
+	assertions.Equal("skip=70000", q.String())
+
+	_, err = ParseQueryString("%zz")
+	assertions.NotNil(err)
+}
+
+func TestParseQuery_Unsupported(t *testing.T) {
+	assertions := assert.New(t)
+
+	q, err := ParseQueryString("access_token=secret&fields.title=Shoe&fields.title[regex]=S.*&callback=x")
+
+	var unsupported UnsupportedQueryParamsError
+	assertions.True(errors.As(err, &unsupported))
+	assertions.Equal([]string{"access_token", "callback", "fields.title[regex]"}, unsupported.Params)
+	assertions.Equal("fields.title=Shoe", q.String())
+}
+
+func TestParseQuery_Invalid(t *testing.T) {
+	assertions := assert.New(t)
+
+	invalid := []string{
+		"limit=many",
+		"limit=2000",
+		"include=11",
+		"skip=-1",
+		"skip=many",
+		"fields.image[exists]=maybe",
+		"fields.store[near]=52.5",
+		"fields.store[near]=north,east",
+		"fields.store[within]=95,0,1",
+		"select=fields.title",
+		"fields.title=a&fields.title=b",
+	}
+
+	for _, query := range invalid {
+		q, err := ParseQueryString(query)
+		assertions.NotNil(err, query)
+		assertions.Nil(q, query)
+	}
+}
@@ -71,9 +71,22 @@ func TestQueryEqual(t *testing.T) {
 	expected.Set("field1", "11")
 	assert.Equal(t, expected.Encode(), q.String())
 
-	q = q.Equal("field1", time.Now())
-	expected.Del("field1")
+	q = q.Equal("field1", time.Date(2021, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600)))
+	expected.Set("field1", "2021-01-02T02:04:05Z")
 	assert.Equal(t, expected.Encode(), q.String())
+
+	q = q.Equal("field1", int64(12)).Equal("field2", float32(1.5))
+	expected.Set("field1", "12")
+	expected.Set("field2", "1.5")
+	assert.Equal(t, expected.Encode(), q.String())
+
+	q = q.Equal("field3", []string{"a"})
+	values, err := q.BuildValues()
+	assert.Nil(t, values)
+	assert.NotNil(t, err)
+	assert.Panics(t, func() {
+		_ = q.String()
+	}, "unsupported values should panic")
 }
 
 func TestQueryNotEqual(t *testing.T) {
@@ -87,9 +100,22 @@ func TestQueryNotEqual(t *testing.T) {
 	expected.Set("field1[ne]", "11")
 	assert.Equal(t, expected.Encode(), q.String())
 
-	q = q.NotEqual("field1", time.Now())
-	expected.Del("field1[ne]")
+	q = q.NotEqual("field1", time.Date(2021, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600)))
+	expected.Set("field1[ne]", "2021-01-02T02:04:05Z")
 	assert.Equal(t, expected.Encode(), q.String())
+
+	q = q.NotEqual("field1", int64(12)).NotEqual("field2", float32(1.5))
+	expected.Set("field1[ne]", "12")
+	expected.Set("field2[ne]", "1.5")
+	assert.Equal(t, expected.Encode(), q.String())
+
+	q = q.NotEqual("field3", []string{"a"})
+	values, err := q.BuildValues()
+	assert.Nil(t, values)
+	assert.NotNil(t, err)
+	assert.Panics(t, func() {
+		_ = q.String()
+	}, "unsupported values should panic")
 }
 
 func TestQueryAll(t *testing.T) {
@@ -138,6 +164,19 @@ func TestQueryLessThan(t *testing.T) {
 	expected = url.Values{}
 	expected.Set("fields.date[lt]", now.Format("2006-01-02 15:04:05"))
 	assert.Equal(t, expected.Encode(), q.String())
+
+	q = NewQuery().LessThan("fields.a", int64(1)).LessThanOrEqual("fields.b", int32(2)).
+		GreaterThan("fields.c", uint(3)).GreaterThanOrEqual("fields.d", float32(4.5))
+	expected = url.Values{}
+	expected.Set("fields.a[lt]", "1")
+	expected.Set("fields.b[lte]", "2")
+	expected.Set("fields.c[gt]", "3")
+	expected.Set("fields.d[gte]", "4.5")
+	assert.Equal(t, expected.Encode(), q.String())
+
+	q = NewQuery().GreaterThan("fields.date", []int{1})
+	_, err := q.BuildValues()
+	assert.NotNil(t, err)
 }
 
 func TestQueryLessThanOrEqual(t *testing.T) {
@@ -287,3 +326,142 @@ func TestQuery(t *testing.T) {
 
 	assert.Equal(t, expected.Encode(), q.String())
 }
+
+func TestQueryFloatAndBool(t *testing.T) {
+	q := NewQuery().
+		Equal("fields.available", true).
+		NotEqual("fields.price", 9.5).
+		GreaterThan("fields.rating", 4.5)
+
+	expected := url.Values{}
+	expected.Set("fields.available", "true")
+	expected.Set("fields.price[ne]", "9.5")
+	expected.Set("fields.rating[gt]", "4.5")
+	assert.Equal(t, expected.Encode(), q.String())
+}
+
+func TestQueryParam(t *testing.T) {
+	q := NewQuery().Param("links_to_entry", "entry_id")
+	expected := url.Values{}
+	expected.Set("links_to_entry", "entry_id")
+	assert.Equal(t, expected.Encode(), q.String())
+}
+
+func TestQueryValidate(t *testing.T) {
+	assert.Nil(t, NewQuery().ContentType("ct").Select([]string{"fields.title"}).Validate())
+	assert.NotNil(t, NewQuery().Include(11).Validate())
+	assert.NotNil(t, NewQuery().Limit(3000).Validate())
+	assert.NotNil(t, NewQuery().Select([]string{"fields.title"}).Validate())
+}
+
+func TestQueryClone(t *testing.T) {
+	q := NewQuery().Equal("fields.title", "a").In("sys.id", []string{"1"}).Order("sys.createdAt", false)
+	clone := q.Clone().Equal("fields.title", "b").Order("sys.id", false).Skip(100).Param("locale", "de")
+
+	expected := url.Values{}
+	expected.Set("fields.title", "a")
+	expected.Set("sys.id[in]", "1")
+	expected.Set("order", "sys.createdAt")
+	assert.Equal(t, expected.Encode(), q.String())
+
+	expected.Set("fields.title", "b")
+	expected.Set("order", "sys.createdAt,sys.id")
+	expected.Set("skip", "100")
+	expected.Set("locale", "de")
+	assert.Equal(t, expected.Encode(), clone.String())
+}
+
+func TestQueryLinks(t *testing.T) {
+	q := NewQuery().LinksToEntry("entry_id")
+	expected := url.Values{}
+	expected.Set("links_to_entry", "entry_id")
+	assert.Equal(t, expected.Encode(), q.String())
+
+	q = NewQuery().LinksToAsset("asset_id")
+	expected = url.Values{}
+	expected.Set("links_to_asset", "asset_id")
+	assert.Equal(t, expected.Encode(), q.String())
+}
+
+func TestQueryLinked(t *testing.T) {
+	q := NewQuery().
+		ContentType("post").
+		Linked("author", "person", NewQuery().
+			ContentType("ignored").
+			Limit(5).
+			Match("fields.name", "Jane").
+			GreaterThan("fields.age", 30))
+
+	expected := url.Values{}
+	expected.Set("content_type", "post")
+	expected.Set("fields.author.sys.contentType.sys.id", "person")
+	expected.Set("fields.author.fields.name[match]", "Jane")
+	expected.Set("fields.author.fields.age[gt]", "30")
+	assert.Equal(t, expected.Encode(), q.String())
+
+	// every value of a parameter is copied
+	linked := NewQuery().setParam("fields.tags", "a", "b")
+	q = NewQuery().Linked("author", "person", linked)
+	assert.Equal(t, []string{"a", "b"}, q.Values()["fields.author.fields.tags"])
+
+	// errors of the linked query fail the query
+	q = NewQuery().Linked("author", "person", NewQuery().Near("fields.location", 100, 0))
+	_, err := q.BuildValues()
+	assert.NotNil(t, err)
+}
+
+func TestQueryTagsAndSys(t *testing.T) {
+	created := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	q := NewQuery().
+		TagsIn("sale", "new").
+		TagsAll("shoes").
+		IDs("a", "b").
+		CreatedAfter(created).
+		UpdatedBefore(created.Add(time.Hour)).
+		Published(true).
+		Archived(false)
+
+	expected := url.Values{}
+	expected.Set("metadata.tags.sys.id[in]", "sale,new")
+	expected.Set("metadata.tags.sys.id[all]", "shoes")
+	expected.Set("sys.id[in]", "a,b")
+	expected.Set("sys.createdAt[gt]", "2022-01-02T03:04:05Z")
+	expected.Set("sys.updatedAt[lt]", "2022-01-02T04:04:05Z")
+	expected.Set("sys.publishedAt[exists]", "true")
+	expected.Set("sys.archivedAt[exists]", "false")
+	assert.Equal(t, expected.Encode(), q.String())
+
+	q = NewQuery().TagsExist(false).CreatedBefore(created).UpdatedAfter(created)
+	expected = url.Values{}
+	expected.Set("metadata.tags[exists]", "false")
+	expected.Set("sys.createdAt[lt]", "2022-01-02T03:04:05Z")
+	expected.Set("sys.updatedAt[gt]", "2022-01-02T03:04:05Z")
+	assert.Equal(t, expected.Encode(), q.String())
+}
+
+func TestQueryGeo(t *testing.T) {
+	q := NewQuery().Near("fields.store", 52.5200066, 13.404954)
+	expected := url.Values{}
+	expected.Set("fields.store[near]", "52.5200066,13.404954")
+	assert.Equal(t, expected.Encode(), q.String())
+
+	q = NewQuery().Within("fields.store", 52.3, 13.1, 52.7, 13.7)
+	expected = url.Values{}
+	expected.Set("fields.store[within]", "52.3,13.1,52.7,13.7")
+	assert.Equal(t, expected.Encode(), q.String())
+
+	q = NewQuery().WithinRadius("fields.store", 52.52, 13.405, 2.5)
+	expected = url.Values{}
+	expected.Set("fields.store[within]", "52.52,13.405,2.5")
+	assert.Equal(t, expected.Encode(), q.String())
+
+	assert.NotNil(t, NewQuery().Near("fields.store", 95, 0).Validate())
+	assert.NotNil(t, NewQuery().Within("fields.store", 52.3, 13.1, 52.7, 200).Validate())
+	assert.NotNil(t, NewQuery().WithinRadius("fields.store", 52.52, 13.405, 0).Validate())
+	assert.NotNil(t, NewQuery().Near("fields.store", 95, 0).Clone().Validate())
+
+	assert.Panics(t, func() {
+		_ = NewQuery().Near("fields.store", 0, -181).String()
+	}, "invalid coordinates should panic")
+}
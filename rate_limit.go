@@ -0,0 +1,86 @@
+package contentful
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxRateLimitRetries is how often a request is sent again after rate limit responses
+const maxRateLimitRetries = 5
+
+// rateLimitGate pauses all requests of a client while the API rate limit is
+// exhausted, a nil gate never pauses
+type rateLimitGate struct {
+	mu    sync.Mutex
+	until time.Time
+}
+
+// wait blocks until the pause is over or ctx is done
+func (g *rateLimitGate) wait(ctx context.Context) error {
+	if g == nil {
+		return nil
+	}
+
+	g.mu.Lock()
+	pause := time.Until(g.until)
+	g.mu.Unlock()
+
+	if pause <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(pause)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// pause holds the requests for d, a longer running pause is kept
+func (g *rateLimitGate) pause(d time.Duration) {
+	if g == nil {
+		time.Sleep(d)
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if until := time.Now().Add(d); until.After(g.until) {
+		g.until = until
+	}
+}
+
+// observe pauses the requests for a second when the response used up the
+// per second limit, so concurrent requests do not run into rate limit errors
+func (g *rateLimitGate) observe(header http.Header) {
+	if g == nil {
+		return
+	}
+
+	remaining, err := strconv.Atoi(header.Get("X-Contentful-RateLimit-Second-Remaining"))
+	if err == nil && remaining <= 0 {
+		g.pause(time.Second)
+	}
+}
+
+type pacingKey struct{}
+
+// withPacing returns a context whose requests share a gate observing the per
+// second limit, FetchAll paces its workers with it
+func withPacing(ctx context.Context) context.Context {
+	return context.WithValue(ctx, pacingKey{}, &rateLimitGate{})
+}
+
+// pacingGate returns the gate of withPacing, nil for requests which are not paced
+func pacingGate(ctx context.Context) *rateLimitGate {
+	gate, _ := ctx.Value(pacingKey{}).(*rateLimitGate)
+	return gate
+}
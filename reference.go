@@ -0,0 +1,86 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+)
+
+// Reference is an entry linking to the target of FindReferences
+type Reference struct {
+	// Entry links to Target
+	Entry Entry
+	// Target is the entry or asset linked by Entry
+	Target *Link
+	// Depth is 1 for entries linking to the searched entity directly, 2 for entries linking to those, etc.
+	Depth int
+}
+
+// IncomingLinks returns all entries linking directly to the entry or asset
+func (service *EntriesService) IncomingLinks(ctx context.Context, env *Environment, target *Link) ([]Entry, error) {
+	if target == nil || target.Sys == nil || target.Sys.ID == "" {
+		return nil, errors.New("link target has no id")
+	}
+
+	query := NewQuery().Order("sys.createdAt", true)
+	switch target.Sys.LinkType {
+	case "Entry":
+		query.LinksToEntry(target.Sys.ID)
+	case "Asset":
+		query.LinksToAsset(target.Sys.ID)
+	default:
+		return nil, errors.New("only entries and assets can be linked, got " + target.Sys.LinkType)
+	}
+
+	col, err := service.List(ctx, env, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return col.collectAll(ctx)
+}
+
+// FindReferences walks the incoming links of the entry or asset, e.g. to check
+// what would break before deleting it. Entries linking to the referencing
+// entries are followed up to maxDepth levels, a maxDepth of 0 walks the whole
+// graph. Every entry is reported once with the shortest depth.
+func (service *EntriesService) FindReferences(ctx context.Context, env *Environment, target *Link, maxDepth int) ([]Reference, error) {
+	if target == nil || target.Sys == nil {
+		return nil, errors.New("link target has no id")
+	}
+
+	var references []Reference
+
+	visited := map[string]bool{target.Sys.LinkType + ":" + target.Sys.ID: true}
+	queue := []*Link{target}
+
+	for depth := 1; len(queue) > 0 && (maxDepth == 0 || depth <= maxDepth); depth++ {
+		var next []*Link
+
+		for _, link := range queue {
+			entries, err := service.IncomingLinks(ctx, env, link)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, entry := range entries {
+				key := "Entry:" + entry.Sys.ID
+				if visited[key] {
+					continue
+				}
+				visited[key] = true
+
+				references = append(references, Reference{Entry: entry, Target: link, Depth: depth})
+				next = append(next, NewLink("Entry", entry.Sys.ID))
+			}
+		}
+
+		queue = next
+	}
+
+	return references, nil
+}
+
+// IncomingLinks returns all entries linking directly to the asset
+func (service *AssetsService) IncomingLinks(ctx context.Context, env *Environment, assetID string) ([]Entry, error) {
+	return service.c.Entries.IncomingLinks(ctx, env, NewLink("Asset", assetID))
+}
@@ -0,0 +1,97 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// referenceServer serves the entries linking to an entry or asset from a fixed link graph
+func referenceServer(assertions *assert.Assertions, incoming map[string][]string) *httptest.Server {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/entries")
+		checkHeaders(r, assertions)
+
+		target := r.URL.Query().Get("links_to_entry")
+		if target == "" {
+			target = "asset:" + r.URL.Query().Get("links_to_asset")
+		}
+
+		items := []string{}
+		for _, id := range incoming[target] {
+			items = append(items, fmt.Sprintf(`{"sys":{"id":%q,"type":"Entry"},"fields":{}}`, id))
+		}
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintf(w, `{"sys":{"type":"Array"},"total":%d,"skip":0,"limit":100,"items":[%s]}`, len(items), strings.Join(items, ","))
+	})
+
+	return httptest.NewServer(handler)
+}
+
+func TestEntriesService_IncomingLinks(t *testing.T) {
+	assertions := assert.New(t)
+
+	// test server
+	server := referenceServer(assertions, map[string][]string{
+		"author":       {"post1", "post2"},
+		"asset:avatar": {"author"},
+	})
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	entries, err := cma.Entries.IncomingLinks(context.Background(), env, NewLink("Entry", "author"))
+	assertions.Nil(err)
+	assertions.Equal(2, len(entries))
+	assertions.Equal("post1", entries[0].Sys.ID)
+
+	entries, err = cma.Assets.IncomingLinks(context.Background(), env, "avatar")
+	assertions.Nil(err)
+	assertions.Equal(1, len(entries))
+	assertions.Equal("author", entries[0].Sys.ID)
+
+	_, err = cma.Entries.IncomingLinks(context.Background(), env, NewLink("ContentType", "post"))
+	assertions.NotNil(err)
+}
+
+func TestEntriesService_FindReferences(t *testing.T) {
+	assertions := assert.New(t)
+
+	// test server
+	server := referenceServer(assertions, map[string][]string{
+		"asset:avatar": {"author"},
+		"author":       {"post1", "post2"},
+		"post1":        {"homepage"},
+		"post2":        {"homepage", "author"},
+		"homepage":     {"post1"},
+	})
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	references, err := cma.Entries.FindReferences(context.Background(), env, NewLink("Asset", "avatar"), 0)
+	assertions.Nil(err)
+	assertions.Equal(4, len(references))
+
+	depths := map[string]int{}
+	for _, reference := range references {
+		depths[reference.Entry.Sys.ID] = reference.Depth
+	}
+	assertions.Equal(map[string]int{"author": 1, "post1": 2, "post2": 2, "homepage": 3}, depths)
+	assertions.Equal("author", references[1].Target.Sys.ID)
+
+	references, err = cma.Entries.FindReferences(context.Background(), env, NewLink("Asset", "avatar"), 2)
+	assertions.Nil(err)
+	assertions.Equal(3, len(references))
+}
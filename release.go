@@ -0,0 +1,202 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// ReleasesService service
+type ReleasesService service
+
+// Release model
+type Release struct {
+	Sys      *Sys            `json:"sys,omitempty"`
+	Title    string          `json:"title"`
+	Entities ReleaseEntities `json:"entities"`
+}
+
+// ReleaseEntities model
+type ReleaseEntities struct {
+	Sys   *Sys    `json:"sys,omitempty"`
+	Items []*Link `json:"items"`
+}
+
+// MarshalJSON for custom json marshaling
+func (release *Release) MarshalJSON() ([]byte, error) {
+	items := release.Entities.Items
+	if items == nil {
+		items = []*Link{}
+	}
+
+	return json.Marshal(&struct {
+		Title    string          `json:"title"`
+		Entities ReleaseEntities `json:"entities"`
+	}{
+		Title: release.Title,
+		Entities: ReleaseEntities{
+			Sys:   &Sys{Type: "Array"},
+			Items: items,
+		},
+	})
+}
+
+// GetVersion returns entity version
+func (release *Release) GetVersion() int {
+	version := 1
+	if release.Sys != nil {
+		version = release.Sys.Version
+	}
+
+	return version
+}
+
+// AddEntry adds a link to the entry to the release
+func (release *Release) AddEntry(entryID string) *Release {
+	release.Entities.Items = append(release.Entities.Items, NewLink("Entry", entryID))
+	return release
+}
+
+// AddAsset adds a link to the asset to the release
+func (release *Release) AddAsset(assetID string) *Release {
+	release.Entities.Items = append(release.Entities.Items, NewLink("Asset", assetID))
+	return release
+}
+
+// List returns the releases collection
+func (service *ReleasesService) List(ctx context.Context, env *Environment, query *Query) (*Collection[Release], error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/releases", env.Sys.Space.Sys.ID, env.Sys.ID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := newCollection[Release](query, service.c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return col, nil
+}
+
+// Get returns a single release
+func (service *ReleasesService) Get(ctx context.Context, env *Environment, releaseID string) (*Release, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s", env.Sys.Space.Sys.ID, env.Sys.ID, releaseID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var release Release
+	if err := service.c.do(req, &release); err != nil {
+		return nil, err
+	}
+
+	return &release, nil
+}
+
+// Upsert updates or creates a new release
+func (service *ReleasesService) Upsert(ctx context.Context, env *Environment, release *Release) error {
+	bytesArray, err := json.Marshal(release)
+	if err != nil {
+		return err
+	}
+
+	var path string
+	var method string
+
+	if release.Sys != nil && release.Sys.ID != "" {
+		path = fmt.Sprintf("/spaces/%s/environments/%s/releases/%s", env.Sys.Space.Sys.ID, env.Sys.ID, release.Sys.ID)
+		method = http.MethodPut
+	} else {
+		path = fmt.Sprintf("/spaces/%s/environments/%s/releases", env.Sys.Space.Sys.ID, env.Sys.ID)
+		method = http.MethodPost
+	}
+
+	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(release.GetVersion()))
+
+	return service.c.do(req, release)
+}
+
+// Delete the release
+func (service *ReleasesService) Delete(ctx context.Context, env *Environment, releaseID string) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s", env.Sys.Space.Sys.ID, env.Sys.ID, releaseID)
+
+	req, err := service.c.newRequest(ctx, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, nil)
+}
+
+// Publish starts publishing all entities of the release. The returned action
+// runs asynchronously, use ReleaseActionsService.WaitUntilDone to wait for it.
+func (service *ReleasesService) Publish(ctx context.Context, env *Environment, release *Release) (*ReleaseAction, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s/published", env.Sys.Space.Sys.ID, env.Sys.ID, release.Sys.ID)
+
+	req, err := service.c.newRequest(ctx, http.MethodPut, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(release.GetVersion()))
+
+	var action ReleaseAction
+	if err := service.c.do(req, &action); err != nil {
+		return nil, err
+	}
+
+	return &action, nil
+}
+
+// Unpublish starts unpublishing all entities of the release
+func (service *ReleasesService) Unpublish(ctx context.Context, env *Environment, release *Release) (*ReleaseAction, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s/published", env.Sys.Space.Sys.ID, env.Sys.ID, release.Sys.ID)
+
+	req, err := service.c.newRequest(ctx, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(release.GetVersion()))
+
+	var action ReleaseAction
+	if err := service.c.do(req, &action); err != nil {
+		return nil, err
+	}
+
+	return &action, nil
+}
+
+// Validate starts validating all entities of the release for publishing
+func (service *ReleasesService) Validate(ctx context.Context, env *Environment, releaseID string) (*ReleaseAction, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s/validate", env.Sys.Space.Sys.ID, env.Sys.ID, releaseID)
+
+	bytesArray, err := json.Marshal(map[string]string{"action": ReleaseActionPublish})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := service.c.newRequest(ctx, http.MethodPost, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return nil, err
+	}
+
+	var action ReleaseAction
+	if err := service.c.do(req, &action); err != nil {
+		return nil, err
+	}
+
+	return &action, nil
+}
@@ -0,0 +1,131 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ReleaseActionsService service
+type ReleaseActionsService service
+
+// noinspection GoUnusedConst
+const (
+	ReleaseActionPublish   = "publish"
+	ReleaseActionUnpublish = "unpublish"
+	ReleaseActionValidate  = "validate"
+)
+
+// noinspection GoUnusedConst
+const (
+	// ReleaseActionStatusInProgress the action is still running
+	ReleaseActionStatusInProgress SysStatus = "inProgress"
+
+	// ReleaseActionStatusSucceeded the action finished successfully
+	ReleaseActionStatusSucceeded SysStatus = "succeeded"
+
+	// ReleaseActionStatusFailed the action finished with an error
+	ReleaseActionStatusFailed SysStatus = "failed"
+)
+
+// ReleaseAction model
+type ReleaseAction struct {
+	Sys    *Sys                `json:"sys"`
+	Action string              `json:"action"`
+	Error  *ReleaseActionError `json:"error,omitempty"`
+}
+
+// ReleaseActionError model
+type ReleaseActionError struct {
+	Sys     *Sys           `json:"sys,omitempty"`
+	Message string         `json:"message,omitempty"`
+	Details map[string]any `json:"details,omitempty"`
+}
+
+// Status returns the release action status
+func (action *ReleaseAction) Status() SysStatus {
+	if action.Sys == nil {
+		return ""
+	}
+
+	return action.Sys.Status
+}
+
+// ReleaseID returns the id of the release the action belongs to, empty if unknown
+func (action *ReleaseAction) ReleaseID() string {
+	if action == nil || action.Sys == nil || action.Sys.Release == nil || action.Sys.Release.Sys == nil {
+		return ""
+	}
+
+	return action.Sys.Release.Sys.ID
+}
+
+// List returns the release actions collection of the environment
+func (service *ReleaseActionsService) List(ctx context.Context, env *Environment, query *Query) (*Collection[ReleaseAction], error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/release_actions", env.Sys.Space.Sys.ID, env.Sys.ID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := newCollection[ReleaseAction](query, service.c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return col, nil
+}
+
+// Get returns a single release action
+func (service *ReleaseActionsService) Get(ctx context.Context, env *Environment, releaseID, actionID string) (*ReleaseAction, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s/actions/%s", env.Sys.Space.Sys.ID, env.Sys.ID, releaseID, actionID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var action ReleaseAction
+	if err := service.c.do(req, &action); err != nil {
+		return nil, err
+	}
+
+	return &action, nil
+}
+
+// WaitUntilDone polls the release action until it succeeded or failed. A failed
+// action is returned as ReleaseActionFailedError. If backoff is nil, DefaultBackoff is used.
+func (service *ReleaseActionsService) WaitUntilDone(ctx context.Context, env *Environment, action *ReleaseAction, backoff *Backoff) (*ReleaseAction, error) {
+	if backoff == nil {
+		backoff = &DefaultBackoff
+	}
+
+	releaseID := action.ReleaseID()
+	if releaseID == "" || action.Sys.ID == "" {
+		return nil, fmt.Errorf("release action has no id or release")
+	}
+	actionID := action.Sys.ID
+
+	err := backoff.poll(ctx, func() (bool, error) {
+		var err error
+		action, err = service.Get(ctx, env, releaseID, actionID)
+		if err != nil {
+			return false, err
+		}
+
+		switch action.Status() {
+		case ReleaseActionStatusSucceeded:
+			return true, nil
+		case ReleaseActionStatusFailed:
+			return false, ReleaseActionFailedError{Action: action}
+		default:
+			return false, nil
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return action, nil
+}
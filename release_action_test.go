@@ -0,0 +1,121 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseActionsService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/release_actions")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("release_action.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	collection, err := cma.ReleaseActions.List(context.Background(), env, nil)
+	assertions.Nil(err)
+	assertions.Equal(1, len(collection.Items))
+	assertions.Equal(ReleaseActionStatusSucceeded, collection.Items[0].Status())
+}
+
+func TestReleaseActionsService_WaitUntilDone(t *testing.T) {
+	assertions := assert.New(t)
+
+	polls := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/releases/release-1/actions/release-action-1")
+
+		checkHeaders(r, assertions)
+
+		polls++
+		w.WriteHeader(200)
+		if polls < 3 {
+			_, _ = fmt.Fprintln(w, readTestData("release_action_1.json"))
+			return
+		}
+		_, _ = fmt.Fprintln(w, readTestData("release_action_succeeded.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	action := &ReleaseAction{Sys: &Sys{ID: "release-action-1", Release: NewLink("Release", "release-1")}}
+	action, err := cma.ReleaseActions.WaitUntilDone(context.Background(), env, action, &Backoff{Initial: time.Millisecond})
+	assertions.Nil(err)
+	assertions.Equal(3, polls)
+	assertions.Equal(ReleaseActionStatusSucceeded, action.Status())
+}
+
+func TestReleaseActionsService_WaitUntilDone_Failed(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("release_action_failed.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	action := &ReleaseAction{Sys: &Sys{ID: "release-action-1", Release: NewLink("Release", "release-1")}}
+	_, err := cma.ReleaseActions.WaitUntilDone(context.Background(), env, action, &Backoff{Initial: time.Millisecond})
+
+	var failed ReleaseActionFailedError
+	assertions.True(errors.As(err, &failed))
+	assertions.Equal("Validation error", failed.Action.Error.Message)
+	assertions.Equal("release action release-action-1 (publish) failed: Validation error", err.Error())
+}
+
+func TestReleaseActionsService_WaitUntilDone_Invalid(t *testing.T) {
+	assertions := assert.New(t)
+
+	// cma client
+	cma = NewCMA(CMAToken)
+
+	for _, action := range []*ReleaseAction{
+		nil,
+		{},
+		{Sys: &Sys{ID: "release-action-1"}},
+		{Sys: &Sys{Release: NewLink("Release", "release-1")}},
+	} {
+		_, err := cma.ReleaseActions.WaitUntilDone(context.Background(), env, action, &Backoff{Initial: time.Millisecond})
+		assertions.NotNil(err)
+	}
+
+	var action *ReleaseAction
+	assertions.Equal("", action.ReleaseID())
+}
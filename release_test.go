@@ -0,0 +1,261 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleasesService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/releases")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("release.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	collection, err := cma.Releases.List(context.Background(), env, nil)
+	assertions.Nil(err)
+	releases := collection.Items
+	assertions.Equal(1, len(releases))
+	assertions.Equal("Summer campaign", releases[0].Title)
+}
+
+func TestReleasesService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/releases/release-1")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("release_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	release, err := cma.Releases.Get(context.Background(), env, "release-1")
+	assertions.Nil(err)
+	assertions.Equal(2, len(release.Entities.Items))
+	assertions.Equal("Entry", release.Entities.Items[0].Sys.LinkType)
+	assertions.Equal("1x0xpXu4pSGS4OukSyWGUK", release.Entities.Items[1].Sys.ID)
+}
+
+func TestReleasesService_Upsert_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/releases")
+
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("Summer campaign", payload["title"])
+		assertions.Nil(payload["sys"])
+
+		entities := payload["entities"].(map[string]interface{})
+		assertions.Equal("Array", entities["sys"].(map[string]interface{})["type"])
+		items := entities["items"].([]interface{})
+		assertions.Equal(2, len(items))
+		assertions.Equal(map[string]interface{}{
+			"type":     "Link",
+			"linkType": "Asset",
+			"id":       "1x0xpXu4pSGS4OukSyWGUK",
+		}, items[1].(map[string]interface{})["sys"])
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, readTestData("release_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	release := &Release{Title: "Summer campaign"}
+	release.AddEntry("5KsDBWseXY6QegucYAoacS").AddAsset("1x0xpXu4pSGS4OukSyWGUK")
+
+	err := cma.Releases.Upsert(context.Background(), env, release)
+	assertions.Nil(err)
+	assertions.Equal("release-1", release.Sys.ID)
+}
+
+func TestReleasesService_Upsert_Update(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "PUT")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/releases/release-1")
+		assertions.Equal("2", r.Header.Get("X-Contentful-Version"))
+
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("Winter campaign", payload["title"])
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("release_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	release, err := releaseFromTestFile("release_1.json")
+	assertions.Nil(err)
+	release.Title = "Winter campaign"
+
+	err = cma.Releases.Upsert(context.Background(), env, release)
+	assertions.Nil(err)
+}
+
+func TestReleasesService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "DELETE")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/releases/release-1")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(204)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	err := cma.Releases.Delete(context.Background(), env, "release-1")
+	assertions.Nil(err)
+}
+
+func TestReleasesService_Publish(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "PUT")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/releases/release-1/published")
+		assertions.Equal("2", r.Header.Get("X-Contentful-Version"))
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(202)
+		_, _ = fmt.Fprintln(w, readTestData("release_action_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	release, err := releaseFromTestFile("release_1.json")
+	assertions.Nil(err)
+
+	action, err := cma.Releases.Publish(context.Background(), env, release)
+	assertions.Nil(err)
+	assertions.Equal("release-action-1", action.Sys.ID)
+	assertions.Equal("release-1", action.ReleaseID())
+	assertions.Equal(ReleaseActionStatusInProgress, action.Status())
+}
+
+func TestReleasesService_Unpublish(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "DELETE")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/releases/release-1/published")
+		assertions.Equal("2", r.Header.Get("X-Contentful-Version"))
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(202)
+		_, _ = fmt.Fprintln(w, readTestData("release_action_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	release, err := releaseFromTestFile("release_1.json")
+	assertions.Nil(err)
+
+	action, err := cma.Releases.Unpublish(context.Background(), env, release)
+	assertions.Nil(err)
+	assertions.Equal("release-action-1", action.Sys.ID)
+}
+
+func TestReleasesService_Validate(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/"+environmentID+"/releases/release-1/validate")
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("publish", payload["action"])
+
+		w.WriteHeader(202)
+		_, _ = fmt.Fprintln(w, readTestData("release_action_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	action, err := cma.Releases.Validate(context.Background(), env, "release-1")
+	assertions.Nil(err)
+	assertions.Equal("release-action-1", action.Sys.ID)
+}
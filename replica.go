@@ -0,0 +1,284 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Replica is an in-process copy of the published content of an environment.
+// It is filled by an initial sync and kept current by delta syncs, lookups,
+// queries and link resolution run against its store without requests.
+type Replica struct {
+	client *Client
+	env    *Environment
+	store  Store
+
+	// OnError is called with the errors of background syncs, which are retried on the next interval
+	OnError func(error)
+
+	syncMu      sync.Mutex
+	mu          sync.Mutex
+	subscribers map[int]func(*StoreChanges)
+	next        int
+}
+
+// NewReplica returns a replica of the environment, client has to be a CDA or CPA client
+func NewReplica(client *Client, env *Environment, store Store) *Replica {
+	return &Replica{
+		client:      client,
+		env:         env,
+		store:       store,
+		subscribers: map[int]func(*StoreChanges){},
+	}
+}
+
+// Store returns the store of the replica
+func (r *Replica) Store() Store {
+	return r.store
+}
+
+// Sync runs an initial sync if the store has no sync token and a delta sync
+// otherwise. Content types and locales have no delta and are fetched again.
+// Subscribers are notified if entries or assets changed.
+func (r *Replica) Sync(ctx context.Context) (*StoreChanges, error) {
+	r.syncMu.Lock()
+	defer r.syncMu.Unlock()
+
+	var result *SyncResult
+	var err error
+	if token := r.store.SyncToken(); token != "" {
+		result, err = r.client.Sync.Delta(ctx, r.env, token)
+	} else {
+		result, err = r.client.Sync.Initial(ctx, r.env)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	changes := &StoreChanges{SyncToken: result.SyncToken}
+	for i := range result.Items {
+		item := &result.Items[i]
+		switch item.Type() {
+		case SyncItemTypeEntry:
+			entry, err := item.Entry()
+			if err != nil {
+				return nil, err
+			}
+			changes.Entries = append(changes.Entries, *entry)
+		case SyncItemTypeAsset:
+			asset, err := item.Asset()
+			if err != nil {
+				return nil, err
+			}
+			changes.Assets = append(changes.Assets, *asset)
+		case SyncItemTypeDeletedEntry:
+			changes.DeletedEntries = append(changes.DeletedEntries, item.Sys.ID)
+		case SyncItemTypeDeletedAsset:
+			changes.DeletedAssets = append(changes.DeletedAssets, item.Sys.ID)
+		}
+	}
+
+	contentTypes, err := r.client.ContentTypes.List(ctx, r.env, nil)
+	if err != nil {
+		return nil, err
+	}
+	changes.ContentTypes, err = contentTypes.collectAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	locales, err := r.client.Locales.List(ctx, r.env, nil)
+	if err != nil {
+		return nil, err
+	}
+	changes.Locales, err = locales.collectAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.store.Apply(changes); err != nil {
+		return nil, err
+	}
+
+	if !changes.Empty() {
+		r.notify(changes)
+	}
+
+	return changes, nil
+}
+
+// Start syncs the replica and keeps syncing it every interval in a background
+// goroutine until ctx is done. It returns once the first sync is done, from
+// then on the replica answers without the network.
+func (r *Replica) Start(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("sync interval must be positive, got %s", interval)
+	}
+
+	if _, err := r.Sync(ctx); err != nil {
+		return err
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := r.Sync(ctx); err != nil && ctx.Err() == nil && r.OnError != nil {
+					r.OnError(err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Subscribe calls fn with the changes of every sync which changed entries or
+// assets, fn runs on the syncing goroutine. The returned func unsubscribes.
+func (r *Replica) Subscribe(fn func(changes *StoreChanges)) func() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id := r.next
+	r.next++
+	r.subscribers[id] = fn
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		delete(r.subscribers, id)
+	}
+}
+
+func (r *Replica) notify(changes *StoreChanges) {
+	r.mu.Lock()
+	subscribers := make([]func(*StoreChanges), 0, len(r.subscribers))
+	for id := 0; id < r.next; id++ {
+		if fn, ok := r.subscribers[id]; ok {
+			subscribers = append(subscribers, fn)
+		}
+	}
+	r.mu.Unlock()
+
+	for _, fn := range subscribers {
+		fn(changes)
+	}
+}
+
+// Entry returns the entry with the given id
+func (r *Replica) Entry(id string) (*Entry, bool) {
+	return r.store.Entry(id)
+}
+
+// Asset returns the asset with the given id
+func (r *Replica) Asset(id string) (*Asset, bool) {
+	return r.store.Asset(id)
+}
+
+// ContentType returns the content type with the given id
+func (r *Replica) ContentType(id string) (*ContentType, bool) {
+	return r.store.ContentType(id)
+}
+
+// evaluator returns a query evaluator using the default locale and the fallbacks of the replicated locales
+func (r *Replica) evaluator() *QueryEvaluator {
+	evaluator := NewQueryEvaluator("")
+	for _, locale := range r.store.Locales() {
+		if locale.Default {
+			evaluator.DefaultLocale = locale.Code
+		}
+		if locale.FallbackCode != "" {
+			evaluator.Fallbacks[locale.Code] = locale.FallbackCode
+		}
+	}
+
+	return evaluator
+}
+
+// Entries runs the query against the replicated entries
+func (r *Replica) Entries(query *Query) (*LocalResult[Entry], error) {
+	return r.evaluator().Entries(r.store.Entries(), query)
+}
+
+// Assets runs the query against the replicated assets
+func (r *Replica) Assets(query *Query) (*LocalResult[Asset], error) {
+	return r.evaluator().Assets(r.store.Assets(), query)
+}
+
+// Resolve returns the replicated entry or asset the link points to
+func (r *Replica) Resolve(link *Link) (any, bool) {
+	if link == nil || link.Sys == nil {
+		return nil, false
+	}
+
+	switch link.Sys.LinkType {
+	case "Entry":
+		return r.store.Entry(link.Sys.ID)
+	case "Asset":
+		return r.store.Asset(link.Sys.ID)
+	default:
+		return nil, false
+	}
+}
+
+// ResolveField returns the entries and assets linked by a field of the entry
+// in the given locale, falling back like the delivery API. Links to entities
+// missing in the replica, e.g. unpublished ones, are left out.
+func (r *Replica) ResolveField(entry *Entry, fieldID, locale string) []any {
+	value, ok := entry.Fields[fieldID]
+	if !ok {
+		return []any{}
+	}
+
+	evaluator := r.evaluator()
+	if locale == "" {
+		locale = evaluator.DefaultLocale
+	}
+
+	localized, ok := value.(map[string]any)
+	if !ok || isLink(localized) {
+		return r.resolveValue(value)
+	}
+
+	seen := map[string]bool{}
+	for code := locale; code != "" && !seen[code]; code = evaluator.Fallbacks[code] {
+		if v, ok := localized[code]; ok {
+			return r.resolveValue(v)
+		}
+		seen[code] = true
+	}
+
+	return []any{}
+}
+
+func (r *Replica) resolveValue(value any) []any {
+	values, ok := value.([]any)
+	if !ok {
+		values = []any{value}
+	}
+
+	resolved := []any{}
+	for _, v := range values {
+		m, ok := v.(map[string]any)
+		if !ok || !isLink(m) {
+			continue
+		}
+
+		sys, _ := m["sys"].(map[string]any)
+		linkType, _ := sys["linkType"].(string)
+		id, _ := sys["id"].(string)
+		if item, ok := r.Resolve(NewLink(linkType, id)); ok {
+			resolved = append(resolved, item)
+		}
+	}
+
+	return resolved
+}
@@ -0,0 +1,260 @@
+package contentful
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// Store holds the entries, assets, content types and locales of a replica.
+// Implementations have to be safe for concurrent use.
+type Store interface {
+	Entry(id string) (*Entry, bool)
+	Asset(id string) (*Asset, bool)
+	ContentType(id string) (*ContentType, bool)
+	Entries() []Entry
+	Assets() []Asset
+	ContentTypes() []ContentType
+	Locales() []Locale
+	SyncToken() string
+
+	// Apply stores the changes of a sync at once
+	Apply(changes *StoreChanges) error
+}
+
+// StoreChanges are the changes of a sync. Content types and locales have no
+// delta, when set they replace the stored ones.
+type StoreChanges struct {
+	SyncToken      string        `json:"syncToken"`
+	Entries        []Entry       `json:"entries,omitempty"`
+	Assets         []Asset       `json:"assets,omitempty"`
+	DeletedEntries []string      `json:"deletedEntries,omitempty"`
+	DeletedAssets  []string      `json:"deletedAssets,omitempty"`
+	ContentTypes   []ContentType `json:"contentTypes,omitempty"`
+	Locales        []Locale      `json:"locales,omitempty"`
+}
+
+// Empty reports whether the changes contain no entry or asset changes
+func (changes *StoreChanges) Empty() bool {
+	return len(changes.Entries) == 0 && len(changes.Assets) == 0 &&
+		len(changes.DeletedEntries) == 0 && len(changes.DeletedAssets) == 0
+}
+
+// MemoryStore is a Store keeping everything in maps
+type MemoryStore struct {
+	mu           sync.RWMutex
+	syncToken    string
+	entries      map[string]*Entry
+	assets       map[string]*Asset
+	contentTypes map[string]*ContentType
+	locales      []Locale
+}
+
+// NewMemoryStore returns an empty memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries:      map[string]*Entry{},
+		assets:       map[string]*Asset{},
+		contentTypes: map[string]*ContentType{},
+		locales:      []Locale{},
+	}
+}
+
+// Entry returns the entry with the given id
+func (s *MemoryStore) Entry(id string) (*Entry, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entry, ok := s.entries[id]
+	return entry, ok
+}
+
+// Asset returns the asset with the given id
+func (s *MemoryStore) Asset(id string) (*Asset, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	asset, ok := s.assets[id]
+	return asset, ok
+}
+
+// ContentType returns the content type with the given id
+func (s *MemoryStore) ContentType(id string) (*ContentType, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ct, ok := s.contentTypes[id]
+	return ct, ok
+}
+
+// Entries returns all entries ordered by id
+func (s *MemoryStore) Entries() []Entry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return storeValues(s.entries)
+}
+
+// Assets returns all assets ordered by id
+func (s *MemoryStore) Assets() []Asset {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return storeValues(s.assets)
+}
+
+// ContentTypes returns all content types ordered by id
+func (s *MemoryStore) ContentTypes() []ContentType {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return storeValues(s.contentTypes)
+}
+
+// Locales returns all locales
+func (s *MemoryStore) Locales() []Locale {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]Locale{}, s.locales...)
+}
+
+// SyncToken returns the token of the last applied sync
+func (s *MemoryStore) SyncToken() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.syncToken
+}
+
+// Apply stores the changes
+func (s *MemoryStore) Apply(changes *StoreChanges) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.apply(changes)
+	return nil
+}
+
+func (s *MemoryStore) apply(changes *StoreChanges) {
+	for i := range changes.Entries {
+		entry := changes.Entries[i]
+		s.entries[entry.Sys.ID] = &entry
+	}
+
+	for i := range changes.Assets {
+		asset := changes.Assets[i]
+		s.assets[asset.Sys.ID] = &asset
+	}
+
+	for _, id := range changes.DeletedEntries {
+		delete(s.entries, id)
+	}
+
+	for _, id := range changes.DeletedAssets {
+		delete(s.assets, id)
+	}
+
+	if changes.ContentTypes != nil {
+		s.contentTypes = map[string]*ContentType{}
+		for i := range changes.ContentTypes {
+			ct := changes.ContentTypes[i]
+			s.contentTypes[ct.Sys.ID] = &ct
+		}
+	}
+
+	if changes.Locales != nil {
+		s.locales = append([]Locale{}, changes.Locales...)
+	}
+
+	s.syncToken = changes.SyncToken
+}
+
+// snapshot returns the whole store as changes to apply on an empty store
+func (s *MemoryStore) snapshot() *StoreChanges {
+	return &StoreChanges{
+		SyncToken:    s.syncToken,
+		Entries:      storeValues(s.entries),
+		Assets:       storeValues(s.assets),
+		ContentTypes: storeValues(s.contentTypes),
+		Locales:      s.locales,
+	}
+}
+
+// storeValues returns the values of a store map ordered by key
+func storeValues[T any](items map[string]*T) []T {
+	ids := make([]string, 0, len(items))
+	for id := range items {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	values := make([]T, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, *items[id])
+	}
+
+	return values
+}
+
+// FileStore is a MemoryStore which writes a snapshot to a file after every
+// change, a replica opened on the same file continues with a delta sync
+type FileStore struct {
+	*MemoryStore
+	path string
+}
+
+// NewFileStore returns a store persisted at path, an existing snapshot is loaded
+func NewFileStore(path string) (*FileStore, error) {
+	s := &FileStore{MemoryStore: NewMemoryStore(), path: path}
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot StoreChanges
+	if err := json.Unmarshal(b, &snapshot); err != nil {
+		return nil, err
+	}
+	s.apply(&snapshot)
+
+	return s, nil
+}
+
+// Apply stores the changes and writes the snapshot, the file is replaced atomically
+func (s *FileStore) Apply(changes *StoreChanges) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.apply(changes)
+
+	b, err := json.Marshal(s.snapshot())
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
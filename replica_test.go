@@ -0,0 +1,192 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func replicaServer(assertions *assert.Assertions) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal("GET", r.Method)
+
+		w.WriteHeader(200)
+		switch r.URL.Path {
+		case "/spaces/" + spaceID + "/environments/" + environmentID + "/content_types":
+			_, _ = fmt.Fprintln(w, `{"total": 1, "limit": 100, "items": [{"sys": {"id": "product", "type": "ContentType"}, "name": "Product", "fields": []}]}`)
+		case "/spaces/" + spaceID + "/environments/" + environmentID + "/locales":
+			_, _ = fmt.Fprintln(w, `{"total": 2, "limit": 100, "items": [{"code": "en-US", "default": true}, {"code": "de-DE", "fallbackCode": "en-US"}]}`)
+		case "/spaces/" + spaceID + "/environments/" + environmentID + "/sync":
+			switch r.URL.Query().Get("sync_token") {
+			case "":
+				_, _ = fmt.Fprintln(w, readTestData("sync_initial.json"))
+			case "page-2":
+				_, _ = fmt.Fprintln(w, readTestData("sync_initial_2.json"))
+			case "delta-1":
+				_, _ = fmt.Fprintln(w, readTestData("sync_delta.json"))
+			default:
+				_, _ = fmt.Fprintln(w, `{"items": [], "nextSyncUrl": "https://cdn.contentful.com/sync?sync_token=delta-2"}`)
+			}
+		default:
+			assertions.Fail("unexpected request", r.URL.Path)
+		}
+	}))
+}
+
+func TestReplica_Sync(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	// test server
+	server := replicaServer(assertions)
+	defer server.Close()
+
+	// cda client
+	cda := NewCDA(CDAToken)
+	cda.BaseURL = server.URL
+
+	replica := NewReplica(cda, env, NewMemoryStore())
+
+	notifications := []*StoreChanges{}
+	unsubscribe := replica.Subscribe(func(changes *StoreChanges) {
+		notifications = append(notifications, changes)
+	})
+
+	changes, err := replica.Sync(context.Background())
+	assertions.Nil(err)
+	assertions.Equal("delta-1", changes.SyncToken)
+	assertions.Equal(3, len(changes.Entries))
+	assertions.Equal(1, len(notifications))
+
+	ct, ok := replica.ContentType("product")
+	assertions.True(ok)
+	assertions.Equal("Product", ct.Name)
+
+	result, err := replica.Entries(NewQuery().ContentType("product").Order("fields.title", false))
+	assertions.Nil(err)
+	assertions.Equal(2, result.Total)
+	assertions.Equal("Running Shoe", result.Items[0].Fields["title"])
+
+	result, err = replica.Entries(NewQuery().Locale("de-DE").Equal("fields.title", "Trail Shoe"))
+	assertions.Nil(err)
+	assertions.Equal(1, result.Total)
+
+	assets, err := replica.Assets(NewQuery().MimeType("image"))
+	assertions.Nil(err)
+	assertions.Equal("photo", assets.Items[0].Sys.ID)
+
+	// links
+	p1, ok := replica.Entry("p1")
+	assertions.True(ok)
+
+	brand := replica.ResolveField(p1, "brand", "de-DE")
+	assertions.Equal(1, len(brand))
+	assertions.Equal("nike", brand[0].(*Entry).Sys.ID)
+
+	images := replica.ResolveField(p1, "images", "")
+	assertions.Equal(1, len(images))
+	assertions.Equal("photo", images[0].(*Asset).Sys.ID)
+
+	_, ok = replica.Resolve(NewLink("Entry", "unknown"))
+	assertions.False(ok)
+
+	// delta
+	changes, err = replica.Sync(context.Background())
+	assertions.Nil(err)
+	assertions.Equal([]string{"p2"}, changes.DeletedEntries)
+	assertions.Equal(2, len(notifications))
+
+	_, ok = replica.Entry("p2")
+	assertions.False(ok)
+
+	p1, _ = replica.Entry("p1")
+	assertions.Equal(map[string]any{"en-US": "Road Shoe"}, p1.Fields["title"])
+
+	// empty syncs do not notify
+	unsubscribe()
+	_, err = replica.Sync(context.Background())
+	assertions.Nil(err)
+	assertions.Equal(2, len(notifications))
+	assertions.Equal("delta-2", replica.Store().SyncToken())
+}
+
+func TestReplica_Start(t *testing.T) {
+	assertions := assert.New(t)
+
+	// test server
+	server := replicaServer(assertions)
+	defer server.Close()
+
+	// cda client
+	cda := NewCDA(CDAToken)
+	cda.BaseURL = server.URL
+
+	replica := NewReplica(cda, env, NewMemoryStore())
+
+	deleted := make(chan []string, 1)
+	replica.Subscribe(func(changes *StoreChanges) {
+		if len(changes.DeletedEntries) > 0 {
+			deleted <- changes.DeletedEntries
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	assertions.NotNil(replica.Start(ctx, 0))
+	assertions.Nil(replica.Start(ctx, 10*time.Millisecond))
+
+	// warmed up
+	_, ok := replica.Entry("p2")
+	assertions.True(ok)
+
+	select {
+	case ids := <-deleted:
+		assertions.Equal([]string{"p2"}, ids)
+	case <-time.After(5 * time.Second):
+		assertions.Fail("background sync did not run")
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	// test server
+	server := replicaServer(assertions)
+	defer server.Close()
+
+	// cda client
+	cda := NewCDA(CDAToken)
+	cda.BaseURL = server.URL
+
+	path := filepath.Join(t.TempDir(), "replica.json")
+	store, err := NewFileStore(path)
+	assertions.Nil(err)
+
+	_, err = NewReplica(cda, env, store).Sync(context.Background())
+	assertions.Nil(err)
+
+	// a store opened on the same file continues with a delta sync
+	store, err = NewFileStore(path)
+	assertions.Nil(err)
+	assertions.Equal("delta-1", store.SyncToken())
+	assertions.Equal(3, len(store.Entries()))
+	assertions.Equal(1, len(store.Assets()))
+	assertions.Equal(2, len(store.Locales()))
+
+	asset, ok := store.Asset("photo")
+	assertions.True(ok)
+	assertions.Equal("image/jpeg", asset.Fields.File.Map["en-US"].ContentType)
+
+	changes, err := NewReplica(cda, env, store).Sync(context.Background())
+	assertions.Nil(err)
+	assertions.Equal([]string{"p2"}, changes.DeletedEntries)
+	assertions.Equal(2, len(store.Entries()))
+}
@@ -28,7 +28,7 @@ func (service *ResourcesService) Get(ctx context.Context, spaceID, resourceID st
 	}
 
 	var resource Resource
-	if ok := service.c.do(req, &resource); ok != nil {
+	if err := service.c.do(req, &resource); err != nil {
 		return nil, err
 	}
 
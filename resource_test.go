@@ -58,7 +58,7 @@ func TestResourcesService_Get_2(t *testing.T) {
 	urc.BaseURL = server.URL
 
 	_, err = urc.Resources.Get(context.Background(), spaceID, "0xvkNW6WdQ8JkWlWZ8BC4x")
-	assertions.Nil(err)
+	assertions.NotNil(err)
 }
 
 func TestResourcesService_Create(t *testing.T) {
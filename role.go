@@ -21,28 +21,219 @@ type Role struct {
 	Permissions Permissions `json:"permissions"`
 }
 
+// noinspection GoUnusedConst
+const (
+	// PolicyEffectAllow allows the actions of a policy
+	PolicyEffectAllow = "allow"
+
+	// PolicyEffectDeny denies the actions of a policy
+	PolicyEffectDeny = "deny"
+)
+
+// noinspection GoUnusedConst
+const (
+	ActionAll       = "all"
+	ActionRead      = "read"
+	ActionCreate    = "create"
+	ActionUpdate    = "update"
+	ActionDelete    = "delete"
+	ActionPublish   = "publish"
+	ActionUnpublish = "unpublish"
+	ActionArchive   = "archive"
+	ActionUnarchive = "unarchive"
+	ActionManage    = "manage"
+)
+
 // Policy model
 type Policy struct {
-	Effect     string     `json:"effect"`
-	Actions    any        `json:"actions"`
-	Constraint Constraint `json:"constraint"`
+	Effect     string      `json:"effect"`
+	Actions    Actions     `json:"actions"`
+	Constraint *Constraint `json:"constraint,omitempty"`
 }
 
 // Permissions model
 type Permissions struct {
-	ContentModel       []string `json:"ContentModel"`
-	Settings           any      `json:"Settings"`
-	ContentDelivery    any      `json:"ContentDelivery"`
-	Environments       any      `json:"Environments"`
-	EnvironmentAliases any      `json:"EnvironmentAliases"`
+	ContentModel       Actions `json:"ContentModel"`
+	Settings           Actions `json:"Settings"`
+	ContentDelivery    Actions `json:"ContentDelivery"`
+	Environments       Actions `json:"Environments"`
+	EnvironmentAliases Actions `json:"EnvironmentAliases"`
 }
 
-// Constraint model
+// Actions model, the API sends either a list of actions or "all"
+type Actions []string
+
+// AllActions grants every action
+var AllActions = Actions{ActionAll}
+
+// Includes reports whether the action is part of the actions
+func (actions Actions) Includes(action string) bool {
+	for _, a := range actions {
+		if a == ActionAll || a == action {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON for custom json marshaling
+func (actions Actions) MarshalJSON() ([]byte, error) {
+	if len(actions) == 1 && actions[0] == ActionAll {
+		return json.Marshal(ActionAll)
+	}
+
+	if actions == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal([]string(actions))
+}
+
+// UnmarshalJSON for custom json unmarshaling
+func (actions *Actions) UnmarshalJSON(data []byte) error {
+	var action string
+	if err := json.Unmarshal(data, &action); err == nil {
+		*actions = Actions{action}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+
+	*actions = list
+	return nil
+}
+
+// Constraint model, exactly one of the operators is set
 type Constraint struct {
-	And   any `json:"and"`
-	Equal any `json:"equal"`
-	Or    any `json:"or"`
-	Not   any `rson:"not"`
+	And    []*Constraint
+	Or     []*Constraint
+	Not    *Constraint
+	Equals *EqualsConstraint
+	In     *InConstraint
+	Paths  []string
+}
+
+// EqualsConstraint compares the value of a document path
+type EqualsConstraint struct {
+	Doc   string
+	Value any
+}
+
+// InConstraint checks that the value of a document path is one of the values
+type InConstraint struct {
+	Doc    string
+	Values []any
+}
+
+type docReference struct {
+	Doc string `json:"doc"`
+}
+
+// MarshalJSON for custom json marshaling
+func (c *Constraint) MarshalJSON() ([]byte, error) {
+	switch {
+	case c.And != nil:
+		return json.Marshal(map[string]any{"and": c.And})
+	case c.Or != nil:
+		return json.Marshal(map[string]any{"or": c.Or})
+	case c.Not != nil:
+		return json.Marshal(map[string]any{"not": c.Not})
+	case c.Equals != nil:
+		return json.Marshal(map[string]any{"equals": []any{docReference{c.Equals.Doc}, c.Equals.Value}})
+	case c.In != nil:
+		values := c.In.Values
+		if values == nil {
+			values = []any{}
+		}
+		return json.Marshal(map[string]any{"in": []any{docReference{c.In.Doc}, values}})
+	case c.Paths != nil:
+		paths := make([]docReference, 0, len(c.Paths))
+		for _, path := range c.Paths {
+			paths = append(paths, docReference{path})
+		}
+		return json.Marshal(map[string]any{"paths": paths})
+	default:
+		return []byte("{}"), nil
+	}
+}
+
+// UnmarshalJSON for custom json unmarshaling
+func (c *Constraint) UnmarshalJSON(data []byte) error {
+	payload := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	if len(payload) > 1 {
+		return fmt.Errorf("constraint should have a single operator, got %d", len(payload))
+	}
+
+	for operator, value := range payload {
+		switch operator {
+		case "and":
+			return json.Unmarshal(value, &c.And)
+		case "or":
+			return json.Unmarshal(value, &c.Or)
+		case "not":
+			return json.Unmarshal(value, &c.Not)
+		case "equals":
+			doc, operand, err := parseDocComparison(value)
+			if err != nil {
+				return err
+			}
+			var v any
+			if err := json.Unmarshal(operand, &v); err != nil {
+				return err
+			}
+			c.Equals = &EqualsConstraint{Doc: doc, Value: v}
+		case "in":
+			doc, operand, err := parseDocComparison(value)
+			if err != nil {
+				return err
+			}
+			var values []any
+			if err := json.Unmarshal(operand, &values); err != nil {
+				return err
+			}
+			c.In = &InConstraint{Doc: doc, Values: values}
+		case "paths":
+			var refs []docReference
+			if err := json.Unmarshal(value, &refs); err != nil {
+				return err
+			}
+			c.Paths = make([]string, 0, len(refs))
+			for _, ref := range refs {
+				c.Paths = append(c.Paths, ref.Doc)
+			}
+		default:
+			return fmt.Errorf("unsupported constraint operator %s", operator)
+		}
+	}
+
+	return nil
+}
+
+// parseDocComparison parses the [{"doc": "path"}, value] operands of equals and in
+func parseDocComparison(data []byte) (string, json.RawMessage, error) {
+	var operands []json.RawMessage
+	if err := json.Unmarshal(data, &operands); err != nil {
+		return "", nil, err
+	}
+
+	if len(operands) != 2 {
+		return "", nil, fmt.Errorf("comparison should have 2 operands, got %d", len(operands))
+	}
+
+	var ref docReference
+	if err := json.Unmarshal(operands[0], &ref); err != nil {
+		return "", nil, err
+	}
+
+	return ref.Doc, operands[1], nil
 }
 
 // GetVersion returns entity version
@@ -85,7 +276,7 @@ func (service *RolesService) Get(ctx context.Context, spaceID, roleID string) (*
 	}
 
 	var role Role
-	if ok := service.c.do(req, &role); ok != nil {
+	if err := service.c.do(req, &role); err != nil {
 		return nil, err
 	}
 
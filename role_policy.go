@@ -0,0 +1,351 @@
+package contentful
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// CurrentUser is replaced by the user of a PermissionRequest when evaluating constraints
+const CurrentUser = "User.current()"
+
+// AndConstraint matches if all constraints match
+func AndConstraint(constraints ...*Constraint) *Constraint {
+	return &Constraint{And: constraints}
+}
+
+// OrConstraint matches if any constraint matches
+func OrConstraint(constraints ...*Constraint) *Constraint {
+	return &Constraint{Or: constraints}
+}
+
+// NotConstraint matches if the constraint does not match
+func NotConstraint(constraint *Constraint) *Constraint {
+	return &Constraint{Not: constraint}
+}
+
+// DocEquals matches if the value at the document path equals value
+func DocEquals(doc string, value any) *Constraint {
+	return &Constraint{Equals: &EqualsConstraint{Doc: doc, Value: value}}
+}
+
+// DocIn matches if the value at the document path is one of values
+func DocIn(doc string, values ...any) *Constraint {
+	return &Constraint{In: &InConstraint{Doc: doc, Values: values}}
+}
+
+// PathsConstraint restricts a policy to the given field paths, "%" matches any path segment
+func PathsConstraint(paths ...string) *Constraint {
+	return &Constraint{Paths: paths}
+}
+
+// EntityTypeIs matches entities of the given type, e.g. "Entry" or "Asset"
+func EntityTypeIs(entityType string) *Constraint {
+	return DocEquals("sys.type", entityType)
+}
+
+// ContentTypeIs matches entries of the given content type
+func ContentTypeIs(contentTypeID string) *Constraint {
+	return DocEquals("sys.contentType.sys.id", contentTypeID)
+}
+
+// CreatedByCurrentUser matches entities created by the user of the request
+func CreatedByCurrentUser() *Constraint {
+	return DocEquals("sys.createdBy.sys.id", CurrentUser)
+}
+
+// RoleBuilder builds a role with a fluent api
+type RoleBuilder struct {
+	role *Role
+}
+
+// NewRoleBuilder initializes a new role builder
+func NewRoleBuilder(name string) *RoleBuilder {
+	return &RoleBuilder{
+		role: &Role{
+			Name:     name,
+			Policies: []Policy{},
+		},
+	}
+}
+
+// Description sets the role description
+func (b *RoleBuilder) Description(description string) *RoleBuilder {
+	b.role.Description = description
+	return b
+}
+
+// Allow adds a policy allowing the actions on entities matching the constraint
+func (b *RoleBuilder) Allow(constraint *Constraint, actions ...string) *RoleBuilder {
+	return b.policy(PolicyEffectAllow, constraint, actions)
+}
+
+// Deny adds a policy denying the actions on entities matching the constraint
+func (b *RoleBuilder) Deny(constraint *Constraint, actions ...string) *RoleBuilder {
+	return b.policy(PolicyEffectDeny, constraint, actions)
+}
+
+func (b *RoleBuilder) policy(effect string, constraint *Constraint, actions []string) *RoleBuilder {
+	b.role.Policies = append(b.role.Policies, Policy{
+		Effect:     effect,
+		Actions:    actions,
+		Constraint: constraint,
+	})
+	return b
+}
+
+// ContentModel sets the content model permissions
+func (b *RoleBuilder) ContentModel(actions ...string) *RoleBuilder {
+	b.role.Permissions.ContentModel = actions
+	return b
+}
+
+// Settings sets the settings permissions
+func (b *RoleBuilder) Settings(actions ...string) *RoleBuilder {
+	b.role.Permissions.Settings = actions
+	return b
+}
+
+// ContentDelivery sets the content delivery permissions
+func (b *RoleBuilder) ContentDelivery(actions ...string) *RoleBuilder {
+	b.role.Permissions.ContentDelivery = actions
+	return b
+}
+
+// Environments sets the environments permissions
+func (b *RoleBuilder) Environments(actions ...string) *RoleBuilder {
+	b.role.Permissions.Environments = actions
+	return b
+}
+
+// EnvironmentAliases sets the environment aliases permissions
+func (b *RoleBuilder) EnvironmentAliases(actions ...string) *RoleBuilder {
+	b.role.Permissions.EnvironmentAliases = actions
+	return b
+}
+
+// Build returns the role
+func (b *RoleBuilder) Build() *Role {
+	return b.role
+}
+
+// PermissionRequest describes an action checked by Role.Allows
+type PermissionRequest struct {
+	// Action e.g. ActionUpdate
+	Action string
+
+	// Entity is an *Entry, *Asset or any value that encodes to a Contentful document
+	Entity any
+
+	// Path optionally restricts the request to a field, e.g. "fields.title.en-US"
+	Path string
+
+	// UserID replaces User.current() in constraints
+	UserID string
+}
+
+// Allows evaluates the role policies locally. An action is allowed if any allow
+// policy matches and no deny policy matches. Without a path, a policy restricted to
+// paths grants the action through its allow policies but is not denied by its deny
+// policies, since those only apply to part of the entity.
+func (r *Role) Allows(req PermissionRequest) (bool, error) {
+	doc, err := toDocument(req.Entity)
+	if err != nil {
+		return false, err
+	}
+
+	allowed := false
+	for _, policy := range r.Policies {
+		if !policy.Actions.Includes(req.Action) {
+			continue
+		}
+
+		eval := constraintEvaluator{
+			doc:          doc,
+			path:         req.Path,
+			userID:       req.UserID,
+			partialMatch: policy.Effect == PolicyEffectAllow,
+		}
+
+		matches := true
+		if policy.Constraint != nil {
+			matches, err = eval.evaluate(policy.Constraint)
+			if err != nil {
+				return false, err
+			}
+		}
+
+		if !matches {
+			continue
+		}
+
+		switch policy.Effect {
+		case PolicyEffectAllow:
+			allowed = true
+		case PolicyEffectDeny:
+			return false, nil
+		default:
+			return false, fmt.Errorf("unknown policy effect %s", policy.Effect)
+		}
+	}
+
+	return allowed, nil
+}
+
+func toDocument(entity any) (map[string]any, error) {
+	if doc, ok := entity.(map[string]any); ok {
+		return doc, nil
+	}
+
+	b, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+type constraintEvaluator struct {
+	doc    map[string]any
+	path   string
+	userID string
+
+	// partialMatch is the result of a paths constraint when no path is requested
+	partialMatch bool
+}
+
+func (e constraintEvaluator) evaluate(c *Constraint) (bool, error) {
+	switch {
+	case c.And != nil:
+		for _, sub := range c.And {
+			ok, err := e.evaluate(sub)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	case c.Or != nil:
+		for _, sub := range c.Or {
+			ok, err := e.evaluate(sub)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	case c.Not != nil:
+		ok, err := e.evaluate(c.Not)
+		return !ok, err
+	case c.Equals != nil:
+		return e.matches(lookupDocument(e.doc, c.Equals.Doc), c.Equals.Value), nil
+	case c.In != nil:
+		value := lookupDocument(e.doc, c.In.Doc)
+		for _, candidate := range c.In.Values {
+			if e.matches(value, candidate) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case c.Paths != nil:
+		if e.path == "" {
+			return e.partialMatch, nil
+		}
+		for _, pattern := range c.Paths {
+			if matchFieldPath(pattern, e.path) {
+				return true, nil
+			}
+		}
+		return false, nil
+	default:
+		return false, fmt.Errorf("empty constraint")
+	}
+}
+
+// matches compares a document value with a constraint value, arrays match if any element matches
+func (e constraintEvaluator) matches(value, expected any) bool {
+	if expected == CurrentUser {
+		expected = e.userID
+	}
+
+	if values, ok := value.([]any); ok {
+		for _, v := range values {
+			if e.matches(v, expected) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if value == nil {
+		return false
+	}
+
+	switch v := value.(type) {
+	case string:
+		s, ok := expected.(string)
+		return ok && v == s
+	case bool:
+		b, ok := expected.(bool)
+		return ok && v == b
+	}
+
+	// json numbers decode as float64 while constraints might be built with ints
+	if f, ok := constraintNumber(value); ok {
+		g, ok := constraintNumber(expected)
+		return ok && f == g
+	}
+
+	return reflect.DeepEqual(value, expected)
+}
+
+// constraintNumber converts the numeric kinds to float64, other values are no numbers
+func constraintNumber(value any) (float64, bool) {
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+func lookupDocument(doc map[string]any, path string) any {
+	var current any = doc
+	for _, segment := range strings.Split(path, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+		current = m[segment]
+	}
+
+	return current
+}
+
+func matchFieldPath(pattern, path string) bool {
+	patternSegments := strings.Split(pattern, ".")
+	pathSegments := strings.Split(path, ".")
+
+	if len(patternSegments) != len(pathSegments) {
+		return false
+	}
+
+	for i, segment := range patternSegments {
+		if segment != "%" && segment != pathSegments[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,163 @@
+package contentful
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstraint_JSON(t *testing.T) {
+	payload := `{
+		"and": [
+			{"equals": [{"doc": "sys.type"}, "Entry"]},
+			{"or": [
+				{"in": [{"doc": "sys.contentType.sys.id"}, ["post", "page"]]},
+				{"not": {"equals": [{"doc": "sys.createdBy.sys.id"}, "User.current()"]}}
+			]},
+			{"paths": [{"doc": "fields.title.%"}]}
+		]
+	}`
+
+	var constraint Constraint
+	require.NoError(t, json.Unmarshal([]byte(payload), &constraint))
+
+	expected := AndConstraint(
+		EntityTypeIs("Entry"),
+		OrConstraint(
+			DocIn("sys.contentType.sys.id", "post", "page"),
+			NotConstraint(CreatedByCurrentUser()),
+		),
+		PathsConstraint("fields.title.%"),
+	)
+	assert.Equal(t, expected, &constraint)
+
+	b, err := json.Marshal(&constraint)
+	require.NoError(t, err)
+	assert.JSONEq(t, payload, string(b))
+
+	err = json.Unmarshal([]byte(`{"equals": [{"doc": "sys.type"}, "Entry"], "not": {}}`), &Constraint{})
+	assert.NotNil(t, err)
+
+	err = json.Unmarshal([]byte(`{"unknown": []}`), &Constraint{})
+	assert.NotNil(t, err)
+}
+
+func TestRole_JSON(t *testing.T) {
+	role, err := roleFromTestData("role_1.json")
+	require.NoError(t, err)
+
+	assert.Equal(t, AllActions, role.Permissions.Settings)
+	assert.Equal(t, Actions{"read"}, role.Permissions.ContentModel)
+	assert.True(t, role.Policies[0].Actions.Includes(ActionPublish))
+	assert.Equal(t, EntityTypeIs("Entry"), role.Policies[0].Constraint)
+
+	b, err := json.Marshal(role)
+	require.NoError(t, err)
+
+	var payload map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &payload))
+	permissions := payload["permissions"].(map[string]interface{})
+	assert.Equal(t, "all", permissions["Settings"])
+	assert.Equal(t, []interface{}{"read"}, permissions["ContentModel"])
+}
+
+func TestRole_Allows(t *testing.T) {
+	role := NewRoleBuilder("Author").
+		Description("Writes blog posts").
+		Allow(EntityTypeIs("Entry"), ActionRead).
+		Allow(AndConstraint(ContentTypeIs("post"), CreatedByCurrentUser()), ActionUpdate, ActionPublish).
+		Allow(AndConstraint(ContentTypeIs("page"), PathsConstraint("fields.body.%")), ActionUpdate).
+		Deny(AndConstraint(ContentTypeIs("post"), PathsConstraint("fields.slug.%")), ActionUpdate).
+		Deny(DocIn("sys.id", "locked"), ActionAll).
+		ContentModel(ActionRead).
+		Settings().
+		Build()
+
+	// entry payload as sent by the API, sys.createdBy is a link
+	post := map[string]any{
+		"sys": map[string]any{
+			"id":          "post-1",
+			"type":        "Entry",
+			"contentType": map[string]any{"sys": map[string]any{"id": "post"}},
+			"createdBy":   map[string]any{"sys": map[string]any{"type": "Link", "linkType": "User", "id": "alice"}},
+		},
+		"fields": map[string]any{"title": map[string]any{"en-US": "Hello"}},
+	}
+
+	page := &Entry{
+		Sys: &Sys{
+			ID:          "page-1",
+			Type:        "Entry",
+			ContentType: &ContentType{Sys: &Sys{ID: "page"}},
+		},
+	}
+
+	locked := &Entry{
+		Sys: &Sys{
+			ID:   "locked",
+			Type: "Entry",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		req      PermissionRequest
+		expected bool
+	}{
+		{"read any entry", PermissionRequest{Action: ActionRead, Entity: page}, true},
+		{"update own post", PermissionRequest{Action: ActionUpdate, Entity: post, UserID: "alice"}, true},
+		{"update post of someone else", PermissionRequest{Action: ActionUpdate, Entity: post, UserID: "bob"}, false},
+		{"update own post title", PermissionRequest{Action: ActionUpdate, Entity: post, UserID: "alice", Path: "fields.title.en-US"}, true},
+		{"update own post slug", PermissionRequest{Action: ActionUpdate, Entity: post, UserID: "alice", Path: "fields.slug.en-US"}, false},
+		{"delete own post", PermissionRequest{Action: ActionDelete, Entity: post, UserID: "alice"}, false},
+		{"update page", PermissionRequest{Action: ActionUpdate, Entity: page}, true},
+		{"update page body", PermissionRequest{Action: ActionUpdate, Entity: page, Path: "fields.body.de"}, true},
+		{"update page title", PermissionRequest{Action: ActionUpdate, Entity: page, Path: "fields.title.de"}, false},
+		{"read locked entry", PermissionRequest{Action: ActionRead, Entity: locked}, false},
+		{"read asset", PermissionRequest{Action: ActionRead, Entity: &Asset{Sys: &Sys{Type: "Asset"}}}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			allowed, err := role.Allows(test.req)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, allowed)
+		})
+	}
+
+	assert.True(t, role.Permissions.ContentModel.Includes(ActionRead))
+	assert.False(t, role.Permissions.Settings.Includes(ActionManage))
+}
+
+func TestConstraint_Matches(t *testing.T) {
+	doc := map[string]any{
+		"sys": map[string]any{"id": "1", "version": float64(3), "archived": true},
+	}
+
+	tests := []struct {
+		name       string
+		constraint *Constraint
+		expected   bool
+	}{
+		{"string", DocEquals("sys.id", "1"), true},
+		{"string and number", DocEquals("sys.id", 1), false},
+		{"number and int", DocEquals("sys.version", 3), true},
+		{"number and float32", DocEquals("sys.version", float32(3)), true},
+		{"number and other number", DocEquals("sys.version", 4), false},
+		{"number and string", DocEquals("sys.version", "3"), false},
+		{"bool", DocEquals("sys.archived", true), true},
+		{"bool and string", DocEquals("sys.archived", "true"), false},
+		{"missing", DocEquals("sys.missing", "1"), false},
+		{"in with mixed types", DocIn("sys.version", "3", 3), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ok, err := constraintEvaluator{doc: doc}.evaluate(test.constraint)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, ok)
+		})
+	}
+}
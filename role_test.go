@@ -91,7 +91,7 @@ func TestRolesService_Get_2(t *testing.T) {
 	cma.BaseURL = server.URL
 
 	_, err = cma.Roles.Get(context.Background(), spaceID, "0xvkNW6WdQ8JkWlWZ8BC4x")
-	assertions.Nil(err)
+	assertions.NotNil(err)
 }
 
 func TestRolesService_Upsert_Create(t *testing.T) {
@@ -113,6 +113,20 @@ func TestRolesService_Upsert_Create(t *testing.T) {
 		assertions.Equal("Author", name)
 		assertions.Equal("Describes the author", description)
 
+		policies := payload["policies"].([]interface{})
+		constraint := policies[0].(map[string]interface{})["constraint"]
+		assertions.Equal(map[string]interface{}{
+			"and": []interface{}{
+				map[string]interface{}{
+					"equals": []interface{}{
+						map[string]interface{}{"doc": "sys.type"},
+						"Entry",
+					},
+				},
+			},
+		}, constraint)
+		assertions.Equal("all", payload["permissions"].(map[string]interface{})["Settings"])
+
 		w.WriteHeader(200)
 		_, _ = fmt.Fprintln(w, readTestData("role_1.json"))
 	})
@@ -131,31 +145,22 @@ func TestRolesService_Upsert_Create(t *testing.T) {
 		Policies: []Policy{
 			{
 				Effect: "allow",
-				Actions: []string{
+				Actions: Actions{
 					"create",
 				},
-				Constraint: Constraint{
-					And: []map[string]any{
-						{
-							"equals": map[string]any{
-								"doc": map[string]any{
-									"doc": "sys.type",
-								},
-								"item_type": "Entry",
-							},
-						},
-					},
-				},
+				Constraint: AndConstraint(
+					EntityTypeIs("Entry"),
+				),
 			},
 		},
 		Permissions: Permissions{
-			ContentModel: []string{
+			ContentModel: Actions{
 				"read",
 			},
-			Settings:           "all",
-			ContentDelivery:    "all",
-			Environments:       "all",
-			EnvironmentAliases: "all",
+			Settings:           AllActions,
+			ContentDelivery:    AllActions,
+			Environments:       AllActions,
+			EnvironmentAliases: AllActions,
 		},
 	}
 
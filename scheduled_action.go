@@ -6,19 +6,53 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
+	"time"
 )
 
 // ScheduledActionsService service
 type ScheduledActionsService service
 
+// noinspection GoUnusedConst
+const (
+	// ScheduledActionPublish publishes the entity at the scheduled time
+	ScheduledActionPublish = "publish"
+
+	// ScheduledActionUnpublish unpublishes the entity at the scheduled time
+	ScheduledActionUnpublish = "unpublish"
+)
+
+// noinspection GoUnusedConst
+const (
+	// ScheduledActionStatusScheduled the action has not been executed yet
+	ScheduledActionStatusScheduled SysStatus = "scheduled"
+
+	// ScheduledActionStatusSucceeded the action has been executed
+	ScheduledActionStatusSucceeded SysStatus = "succeeded"
+
+	// ScheduledActionStatusFailed the action has been executed with an error
+	ScheduledActionStatusFailed SysStatus = "failed"
+
+	// ScheduledActionStatusCanceled the action has been canceled
+	ScheduledActionStatusCanceled SysStatus = "canceled"
+)
+
 // ScheduledAction model
 type ScheduledAction struct {
-	Sys          *Sys              `json:"sys"`
-	Entity       Entity            `json:"entity"`
-	Environment  EnvironmentLink   `json:"environment"`
-	ScheduledFor map[string]string `json:"scheduledFor"`
-	Action       string            `json:"action"`
+	Sys          *Sys            `json:"sys"`
+	Entity       Entity          `json:"entity"`
+	Environment  EnvironmentLink `json:"environment"`
+	ScheduledFor ScheduledFor    `json:"scheduledFor"`
+	Action       string          `json:"action"`
+}
+
+// ScheduledFor model
+type ScheduledFor struct {
+	Datetime time.Time `json:"datetime"`
+
+	// Timezone is an IANA timezone like "Europe/Berlin" used to display the schedule
+	Timezone string `json:"timezone,omitempty"`
 }
 
 // Entity model
@@ -26,6 +60,31 @@ type Entity struct {
 	Sys Sys `json:"sys"`
 }
 
+// NewEntryEntity returns the entity of a scheduled action for an entry
+func NewEntryEntity(entryID string) Entity {
+	return newEntity("Entry", entryID)
+}
+
+// NewAssetEntity returns the entity of a scheduled action for an asset
+func NewAssetEntity(assetID string) Entity {
+	return newEntity("Asset", assetID)
+}
+
+// NewReleaseEntity returns the entity of a scheduled action for a release
+func NewReleaseEntity(releaseID string) Entity {
+	return newEntity("Release", releaseID)
+}
+
+func newEntity(linkType, id string) Entity {
+	return Entity{
+		Sys: Sys{
+			Type:     "Link",
+			LinkType: linkType,
+			ID:       id,
+		},
+	}
+}
+
 // EnvironmentLink model
 type EnvironmentLink struct {
 	Sys Sys `json:"sys"`
@@ -41,15 +100,52 @@ func (scheduledAction *ScheduledAction) GetVersion() int {
 	return version
 }
 
-// List returns scheduled actions collection
-func (service *ScheduledActionsService) List(ctx context.Context, spaceID, entryID string, query *Query) (*Collection[ScheduledAction], error) {
-	path := fmt.Sprintf("/spaces/%s/scheduled_actions?entity.sys.id=%s&environment.sys.id=%s", spaceID, entryID, service.c.Environment)
+// Status returns the scheduled action status
+func (scheduledAction *ScheduledAction) Status() SysStatus {
+	if scheduledAction.Sys == nil {
+		return ""
+	}
+
+	return scheduledAction.Sys.Status
+}
+
+// List returns the scheduled actions collection of an entry, asset or release
+func (service *ScheduledActionsService) List(ctx context.Context, env *Environment, entityID string, query *Query) (*Collection[ScheduledAction], error) {
+	if entityID == "" {
+		return nil, fmt.Errorf("scheduled actions need an entity id")
+	}
+
+	if query == nil {
+		query = NewQuery().Order("sys.createdAt", true)
+	} else {
+		query = query.Clone()
+	}
+	query.Equal("entity.sys.id", entityID)
+
+	return service.ListAll(ctx, env, query)
+}
+
+// ListAll returns the scheduled actions collection of the whole environment.
+// Filter by status with query.Equal("sys.status", ScheduledActionStatusScheduled).
+func (service *ScheduledActionsService) ListAll(ctx context.Context, env *Environment, query *Query) (*Collection[ScheduledAction], error) {
+	if env == nil || env.Sys == nil || env.Sys.Space == nil || env.Sys.Space.Sys == nil {
+		return nil, fmt.Errorf("scheduled actions need an environment of a space")
+	}
+
+	path := fmt.Sprintf("/spaces/%s/scheduled_actions", env.Sys.Space.Sys.ID)
 
 	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if query == nil {
+		query = NewQuery().Order("sys.createdAt", true)
+	} else {
+		query = query.Clone()
+	}
+	query.Equal("environment.sys.id", env.Sys.ID)
+
 	col, err := newCollection[ScheduledAction](query, service.c, req)
 	if err != nil {
 		return nil, err
@@ -58,26 +154,89 @@ func (service *ScheduledActionsService) List(ctx context.Context, spaceID, entry
 	return col, nil
 }
 
-// Delete the scheduled action
-func (service *ScheduledActionsService) Delete(ctx context.Context, spaceID, entryID, scheduledActionID string) error {
-	path := fmt.Sprintf("/spaces/%s/scheduled_actions/%s?entity.sys.id=%s&environment.sys.id=%s", spaceID, scheduledActionID, entryID, service.c.Environment)
-	method := "DELETE"
+// Get returns a single scheduled action
+func (service *ScheduledActionsService) Get(ctx context.Context, env *Environment, scheduledActionID string) (*ScheduledAction, error) {
+	path := fmt.Sprintf("/spaces/%s/scheduled_actions/%s", env.Sys.Space.Sys.ID, scheduledActionID)
+	query := url.Values{}
+	query.Set("environment.sys.id", env.Sys.ID)
 
-	req, err := service.c.newRequest(ctx, method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, query, nil)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	var scheduledAction ScheduledAction
+	if err := service.c.do(req, &scheduledAction); err != nil {
+		return nil, err
 	}
 
-	return service.c.do(req, nil)
+	return &scheduledAction, nil
+}
+
+// Delete the scheduled action, use Cancel to get the canceled scheduled action
+func (service *ScheduledActionsService) Delete(ctx context.Context, env *Environment, scheduledActionID string) error {
+	_, err := service.Cancel(ctx, env, scheduledActionID)
+	return err
+}
+
+// Cancel cancels the scheduled action and returns it with the canceled status
+func (service *ScheduledActionsService) Cancel(ctx context.Context, env *Environment, scheduledActionID string) (*ScheduledAction, error) {
+	path := fmt.Sprintf("/spaces/%s/scheduled_actions/%s", env.Sys.Space.Sys.ID, scheduledActionID)
+	query := url.Values{}
+	query.Set("environment.sys.id", env.Sys.ID)
+
+	req, err := service.c.newRequest(ctx, http.MethodDelete, path, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var scheduledAction ScheduledAction
+	if err := service.c.do(req, &scheduledAction); err != nil {
+		return nil, err
+	}
+
+	return &scheduledAction, nil
+}
+
+// CancelScheduled cancels all pending scheduled actions of an entry, asset or release
+func (service *ScheduledActionsService) CancelScheduled(ctx context.Context, env *Environment, entityID string) ([]*ScheduledAction, error) {
+	query := NewQuery().Order("sys.createdAt", true).Equal("sys.status", string(ScheduledActionStatusScheduled))
+	col, err := service.List(ctx, env, entityID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledActions, err := col.collectAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var canceled []*ScheduledAction
+	for _, scheduledAction := range scheduledActions {
+		if scheduledAction.Status() != ScheduledActionStatusScheduled {
+			continue
+		}
+
+		c, err := service.Cancel(ctx, env, scheduledAction.Sys.ID)
+		if err != nil {
+			return canceled, err
+		}
+
+		canceled = append(canceled, c)
+	}
+
+	return canceled, nil
 }
 
 // Create creates a new scheduled actions
-func (service *ScheduledActionsService) Create(ctx context.Context, spaceID, entryID string, scheduledAction *ScheduledAction) error {
+func (service *ScheduledActionsService) Create(ctx context.Context, env *Environment, scheduledAction *ScheduledAction) error {
+	service.setEnvironment(env, scheduledAction)
+
 	bytesArray, err := json.Marshal(scheduledAction)
 	if err != nil {
 		return err
 	}
-	path := fmt.Sprintf("/spaces/%s/scheduled_actions?entity.sys.id=%s&environment.sys.id=%s", spaceID, entryID, service.c.Environment)
+	path := fmt.Sprintf("/spaces/%s/scheduled_actions", env.Sys.Space.Sys.ID)
 	method := "POST"
 
 	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
@@ -85,7 +244,65 @@ func (service *ScheduledActionsService) Create(ctx context.Context, spaceID, ent
 		return err
 	}
 
+	return service.c.do(req, scheduledAction)
+}
+
+// Update reschedules the scheduled action
+func (service *ScheduledActionsService) Update(ctx context.Context, env *Environment, scheduledAction *ScheduledAction) error {
+	service.setEnvironment(env, scheduledAction)
+
+	bytesArray, err := json.Marshal(&struct {
+		Entity       Entity          `json:"entity"`
+		Environment  EnvironmentLink `json:"environment"`
+		ScheduledFor ScheduledFor    `json:"scheduledFor"`
+		Action       string          `json:"action"`
+	}{
+		Entity:       scheduledAction.Entity,
+		Environment:  scheduledAction.Environment,
+		ScheduledFor: scheduledAction.ScheduledFor,
+		Action:       scheduledAction.Action,
+	})
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/spaces/%s/scheduled_actions/%s", env.Sys.Space.Sys.ID, scheduledAction.Sys.ID)
+	query := url.Values{}
+	query.Set("environment.sys.id", env.Sys.ID)
+
+	req, err := service.c.newRequest(ctx, http.MethodPut, path, query, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
 	req.Header.Set("X-Contentful-Version", strconv.Itoa(scheduledAction.GetVersion()))
 
 	return service.c.do(req, scheduledAction)
 }
+
+func (service *ScheduledActionsService) setEnvironment(env *Environment, scheduledAction *ScheduledAction) {
+	if scheduledAction.Environment.Sys.ID == "" {
+		scheduledAction.Environment = EnvironmentLink{
+			Sys: Sys{
+				Type:     "Link",
+				LinkType: "Environment",
+				ID:       env.Sys.ID,
+			},
+		}
+	}
+}
+
+// ScheduleRelease schedules publishing or unpublishing all entities of a release
+func (service *ScheduledActionsService) ScheduleRelease(ctx context.Context, env *Environment, releaseID, action string, scheduledFor ScheduledFor) (*ScheduledAction, error) {
+	scheduledAction := &ScheduledAction{
+		Entity:       NewReleaseEntity(releaseID),
+		ScheduledFor: scheduledFor,
+		Action:       action,
+	}
+
+	if err := service.Create(ctx, env, scheduledAction); err != nil {
+		return nil, err
+	}
+
+	return scheduledAction, nil
+}
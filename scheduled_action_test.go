@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -17,7 +18,9 @@ func TestScheduledActionsService_List(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions?entity.sys.id=5KsDBWseXY6QegucYAoacS&environment.sys.id=master")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions")
+		assertions.Equal("5KsDBWseXY6QegucYAoacS", r.URL.Query().Get("entity.sys.id"))
+		assertions.Equal(environmentID, r.URL.Query().Get("environment.sys.id"))
 
 		checkHeaders(r, assertions)
 
@@ -33,11 +36,18 @@ func TestScheduledActionsService_List(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	collection, err := cma.ScheduledActions.List(context.Background(), spaceID, "5KsDBWseXY6QegucYAoacS", nil)
+	collection, err := cma.ScheduledActions.List(context.Background(), env, "5KsDBWseXY6QegucYAoacS", nil)
 	assertions.Nil(err)
 	scheduledActions := collection.Items
 	assertions.Equal(1, len(scheduledActions))
 	assertions.Equal("publish", scheduledActions[0].Action)
+
+	// the query of the caller is not changed
+	query := NewQuery().Limit(10)
+	_, err = cma.ScheduledActions.List(context.Background(), env, "5KsDBWseXY6QegucYAoacS", query)
+	assertions.Nil(err)
+	assertions.Equal("", query.Values().Get("entity.sys.id"))
+	assertions.Equal("", query.Values().Get("environment.sys.id"))
 }
 
 func TestScheduledActionsService_Delete(t *testing.T) {
@@ -46,10 +56,12 @@ func TestScheduledActionsService_Delete(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "DELETE")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions/3A13SXSDwO8c46NrjigFYT?entity.sys.id=5KsDBWseXY6QegucYAoacS&environment.sys.id=master")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions/3A13SXSDwO8c46NrjigFYT")
+		assertions.Equal(environmentID, r.URL.Query().Get("environment.sys.id"))
 		checkHeaders(r, assertions)
 
 		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("scheduled_action_canceled.json"))
 	})
 
 	// test server
@@ -63,7 +75,7 @@ func TestScheduledActionsService_Delete(t *testing.T) {
 	scheduledAction, err := scheduledActionFromTestFile("scheduled_action_canceled.json")
 	assertions.Nil(err)
 
-	err = cma.ScheduledActions.Delete(context.Background(), spaceID, "5KsDBWseXY6QegucYAoacS", scheduledAction.Sys.ID)
+	err = cma.ScheduledActions.Delete(context.Background(), env, scheduledAction.Sys.ID)
 	assertions.Nil(err)
 	assertions.Equal("3A13SXSDwO8c46NrjigFYT", scheduledAction.Sys.ID)
 }
@@ -73,13 +85,18 @@ func TestScheduledActionsService_Create(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "POST")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions?entity.sys.id=5KsDBWseXY6QegucYAoacS&environment.sys.id=master")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions")
 		checkHeaders(r, assertions)
 
 		var payload map[string]interface{}
 		err := json.NewDecoder(r.Body).Decode(&payload)
 		assertions.Nil(err)
 		assertions.Equal("publish", payload["action"])
+		assertions.Equal(map[string]interface{}{
+			"datetime": "2119-09-02T14:00:00Z",
+			"timezone": "Europe/Berlin",
+		}, payload["scheduledFor"])
+		assertions.Equal("", r.Header.Get("X-Contentful-Version"))
 
 		w.WriteHeader(201)
 		_, _ = fmt.Fprintln(w, readTestData("scheduled_action_created.json"))
@@ -111,15 +128,195 @@ func TestScheduledActionsService_Create(t *testing.T) {
 				ID:       "master",
 			},
 		},
-		ScheduledFor: map[string]string{
-			"datetime": "2119-09-02T14:00:00.000Z",
+		ScheduledFor: ScheduledFor{
+			Datetime: time.Date(2119, 9, 2, 14, 0, 0, 0, time.UTC),
+			Timezone: "Europe/Berlin",
 		},
-		Action: "publish",
+		Action: ScheduledActionPublish,
 	}
 
-	err := cma.ScheduledActions.Create(context.Background(), spaceID, "5KsDBWseXY6QegucYAoacS", scheduledAction)
+	err := cma.ScheduledActions.Create(context.Background(), env, scheduledAction)
 	assertions.Nil(err)
 
 	assertions.Equal("publish", scheduledAction.Action)
 	assertions.Equal(2, scheduledAction.GetVersion())
+	assertions.Equal(ScheduledActionStatusScheduled, scheduledAction.Status())
+	assertions.Equal(time.Date(2119, 9, 2, 14, 0, 0, 0, time.UTC), scheduledAction.ScheduledFor.Datetime)
+}
+
+func TestScheduledActionsService_ListAll(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions")
+		assertions.Equal("", r.URL.Query().Get("entity.sys.id"))
+		assertions.Equal(environmentID, r.URL.Query().Get("environment.sys.id"))
+		assertions.Equal("scheduled", r.URL.Query().Get("sys.status"))
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("scheduled_action.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	query := NewQuery().Equal("sys.status", string(ScheduledActionStatusScheduled))
+	collection, err := cma.ScheduledActions.ListAll(context.Background(), env, query)
+	assertions.Nil(err)
+	assertions.Equal(1, len(collection.Items))
+
+	// the query of the caller is not changed
+	assertions.Equal("", query.Values().Get("environment.sys.id"))
+
+	_, err = cma.ScheduledActions.ListAll(context.Background(), nil, query)
+	assertions.NotNil(err)
+	_, err = cma.ScheduledActions.List(context.Background(), env, "", query)
+	assertions.NotNil(err)
+}
+
+func TestScheduledActionsService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions/3A13SXSDwO8c46NrjigFYT")
+		assertions.Equal(environmentID, r.URL.Query().Get("environment.sys.id"))
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("scheduled_action_created.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	scheduledAction, err := cma.ScheduledActions.Get(context.Background(), env, "3A13SXSDwO8c46NrjigFYT")
+	assertions.Nil(err)
+	assertions.Equal("5KsDBWseXY6QegucYAoacS", scheduledAction.Entity.Sys.ID)
+	assertions.Equal(ScheduledActionStatusScheduled, scheduledAction.Status())
+}
+
+func TestScheduledActionsService_Update(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "PUT")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions/3A13SXSDwO8c46NrjigFYT")
+		assertions.Equal(environmentID, r.URL.Query().Get("environment.sys.id"))
+		assertions.Equal("2", r.Header.Get("X-Contentful-Version"))
+
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Nil(payload["sys"])
+		assertions.Equal("2120-01-01T09:00:00Z", payload["scheduledFor"].(map[string]interface{})["datetime"])
+		assertions.Equal("Release", payload["entity"].(map[string]interface{})["sys"].(map[string]interface{})["linkType"])
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("scheduled_action_created.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	scheduledAction, err := scheduledActionFromTestFile("scheduled_action_created.json")
+	assertions.Nil(err)
+
+	scheduledAction.Entity = NewReleaseEntity("release-1")
+	scheduledAction.ScheduledFor.Datetime = time.Date(2120, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	err = cma.ScheduledActions.Update(context.Background(), env, scheduledAction)
+	assertions.Nil(err)
+}
+
+func TestScheduledActionsService_CancelScheduled(t *testing.T) {
+	assertions := assert.New(t)
+
+	canceled := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkHeaders(r, assertions)
+
+		switch r.Method {
+		case "GET":
+			assertions.Equal("5KsDBWseXY6QegucYAoacS", r.URL.Query().Get("entity.sys.id"))
+			assertions.Equal("scheduled", r.URL.Query().Get("sys.status"))
+			_, _ = fmt.Fprintln(w, readTestData("scheduled_action.json"))
+		case "DELETE":
+			assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions/3A13SXSDwO8c46NrjigFYT")
+			canceled++
+			_, _ = fmt.Fprintln(w, readTestData("scheduled_action_canceled.json"))
+		}
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	scheduledActions, err := cma.ScheduledActions.CancelScheduled(context.Background(), env, "5KsDBWseXY6QegucYAoacS")
+	assertions.Nil(err)
+	assertions.Equal(1, canceled)
+	assertions.Equal(1, len(scheduledActions))
+	assertions.Equal(ScheduledActionStatusCanceled, scheduledActions[0].Status())
+}
+
+func TestScheduledActionsService_ScheduleRelease(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions")
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal(map[string]interface{}{
+			"type":     "Link",
+			"linkType": "Release",
+			"id":       "release-1",
+		}, payload["entity"].(map[string]interface{})["sys"])
+		assertions.Equal(environmentID, payload["environment"].(map[string]interface{})["sys"].(map[string]interface{})["id"])
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, readTestData("scheduled_action_created.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	scheduledAction, err := cma.ScheduledActions.ScheduleRelease(context.Background(), env, "release-1", ScheduledActionPublish, ScheduledFor{
+		Datetime: time.Date(2119, 9, 2, 14, 0, 0, 0, time.UTC),
+	})
+	assertions.Nil(err)
+	assertions.Equal("3A13SXSDwO8c46NrjigFYT", scheduledAction.Sys.ID)
 }
@@ -30,23 +30,27 @@ type ContentTypeSnapshot struct {
 
 // ContentTypeSnapshotDetail model
 type ContentTypeSnapshotDetail struct {
-	Name   string              `json:"name"`
-	Fields []ContentTypeFields `json:"fields"`
-	Sys    *Sys                `json:"sys"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description,omitempty"`
+	DisplayField string   `json:"displayField,omitempty"`
+	Fields       []*Field `json:"fields"`
+	Sys          *Sys     `json:"sys"`
 }
 
-// ContentTypeFields model
-type ContentTypeFields struct {
-	ID        string `json:"id"`
-	Name      string `json:"name"`
-	Required  bool   `json:"required"`
-	Localized bool   `json:"localized"`
-	Type      string `json:"type"`
+// ContentType returns the content type as it was when the snapshot was taken
+func (detail ContentTypeSnapshotDetail) ContentType() *ContentType {
+	return &ContentType{
+		Sys:          detail.Sys,
+		Name:         detail.Name,
+		Description:  detail.Description,
+		Fields:       detail.Fields,
+		DisplayField: detail.DisplayField,
+	}
 }
 
 // ListEntrySnapshots returns snapshot collection
-func (service *SnapshotsService) ListEntrySnapshots(ctx context.Context, spaceID, entryID string, query *Query) (*Collection[EntrySnapshot], error) {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/snapshots", spaceID, service.c.Environment, entryID)
+func (service *SnapshotsService) ListEntrySnapshots(ctx context.Context, env *Environment, entryID string, query *Query) (*Collection[EntrySnapshot], error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/snapshots", env.Sys.Space.Sys.ID, env.Sys.ID, entryID)
 
 	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
@@ -62,8 +66,8 @@ func (service *SnapshotsService) ListEntrySnapshots(ctx context.Context, spaceID
 }
 
 // GetEntrySnapshot returns a single snapshot of an entry
-func (service *SnapshotsService) GetEntrySnapshot(ctx context.Context, spaceID, entryID, snapshotID string) (*EntrySnapshot, error) {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/snapshots/%s", spaceID, service.c.Environment, entryID, snapshotID)
+func (service *SnapshotsService) GetEntrySnapshot(ctx context.Context, env *Environment, entryID, snapshotID string) (*EntrySnapshot, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/snapshots/%s", env.Sys.Space.Sys.ID, env.Sys.ID, entryID, snapshotID)
 	query := url.Values{}
 	method := "GET"
 
@@ -73,7 +77,7 @@ func (service *SnapshotsService) GetEntrySnapshot(ctx context.Context, spaceID,
 	}
 
 	var entrySnapshot EntrySnapshot
-	if ok := service.c.do(req, &entrySnapshot); ok != nil {
+	if err := service.c.do(req, &entrySnapshot); err != nil {
 		return nil, err
 	}
 
@@ -81,8 +85,8 @@ func (service *SnapshotsService) GetEntrySnapshot(ctx context.Context, spaceID,
 }
 
 // ListContentTypeSnapshots returns snapshot collection
-func (service *SnapshotsService) ListContentTypeSnapshots(ctx context.Context, spaceID, contentTypeID string, query *Query) (*Collection[ContentTypeSnapshot], error) {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/snapshots", spaceID, service.c.Environment, contentTypeID)
+func (service *SnapshotsService) ListContentTypeSnapshots(ctx context.Context, env *Environment, contentTypeID string, query *Query) (*Collection[ContentTypeSnapshot], error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/snapshots", env.Sys.Space.Sys.ID, env.Sys.ID, contentTypeID)
 
 	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
@@ -98,8 +102,8 @@ func (service *SnapshotsService) ListContentTypeSnapshots(ctx context.Context, s
 }
 
 // GetContentTypeSnapshots returns a single snapshot of an entry
-func (service *SnapshotsService) GetContentTypeSnapshots(ctx context.Context, spaceID, contentTypeID, snapshotID string) (*ContentTypeSnapshot, error) {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/snapshots/%s", spaceID, service.c.Environment, contentTypeID, snapshotID)
+func (service *SnapshotsService) GetContentTypeSnapshots(ctx context.Context, env *Environment, contentTypeID, snapshotID string) (*ContentTypeSnapshot, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/snapshots/%s", env.Sys.Space.Sys.ID, env.Sys.ID, contentTypeID, snapshotID)
 	query := url.Values{}
 	method := "GET"
 
@@ -109,7 +113,7 @@ func (service *SnapshotsService) GetContentTypeSnapshots(ctx context.Context, sp
 	}
 
 	var contentTypeSnapshot ContentTypeSnapshot
-	if ok := service.c.do(req, &contentTypeSnapshot); ok != nil {
+	if err := service.c.do(req, &contentTypeSnapshot); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,164 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// FieldChangeType kind of a FieldChange
+type FieldChangeType string
+
+// noinspection GoUnusedConst
+const (
+	FieldChangeAdded   FieldChangeType = "added"
+	FieldChangeRemoved FieldChangeType = "removed"
+	FieldChangeChanged FieldChangeType = "changed"
+)
+
+// FieldChange is the change of a single locale of an entry field
+type FieldChange struct {
+	Type   FieldChangeType
+	Field  string
+	Locale string
+	From   any
+	To     any
+}
+
+func (change FieldChange) String() string {
+	return fmt.Sprintf("%s %s.%s", change.Type, change.Field, change.Locale)
+}
+
+// DiffEntryFields compares two field maps of the form field -> locale -> value.
+// The changes are sorted by field and locale.
+func DiffEntryFields(from, to map[string]any) ([]FieldChange, error) {
+	fromFields, err := localizedFields(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toFields, err := localizedFields(to)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := map[[2]string]bool{}
+	for field, locales := range fromFields {
+		for locale := range locales {
+			keys[[2]string{field, locale}] = true
+		}
+	}
+	for field, locales := range toFields {
+		for locale := range locales {
+			keys[[2]string{field, locale}] = true
+		}
+	}
+
+	var changes []FieldChange
+	for key := range keys {
+		field, locale := key[0], key[1]
+		fromValue, inFrom := fromFields[field][locale]
+		toValue, inTo := toFields[field][locale]
+
+		change := FieldChange{Field: field, Locale: locale, From: fromValue, To: toValue}
+		switch {
+		case !inFrom:
+			change.Type = FieldChangeAdded
+		case !inTo:
+			change.Type = FieldChangeRemoved
+		case !reflect.DeepEqual(fromValue, toValue):
+			change.Type = FieldChangeChanged
+		default:
+			continue
+		}
+
+		changes = append(changes, change)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Field != changes[j].Field {
+			return changes[i].Field < changes[j].Field
+		}
+		return changes[i].Locale < changes[j].Locale
+	})
+
+	return changes, nil
+}
+
+// DiffEntrySnapshots returns the field changes from one snapshot to another
+func DiffEntrySnapshots(from, to *EntrySnapshot) ([]FieldChange, error) {
+	return DiffEntryFields(from.EntrySnapshotDetail.Fields, to.EntrySnapshotDetail.Fields)
+}
+
+// DiffEntrySnapshot returns the field changes from the snapshot to the entry
+func DiffEntrySnapshot(snapshot *EntrySnapshot, entry *Entry) ([]FieldChange, error) {
+	return DiffEntryFields(snapshot.EntrySnapshotDetail.Fields, entry.Fields)
+}
+
+// DiffEntry fetches the snapshot and the current entry and returns the changes
+// made to the entry since the snapshot
+func (service *SnapshotsService) DiffEntry(ctx context.Context, env *Environment, entryID, snapshotID string) ([]FieldChange, error) {
+	snapshot, err := service.GetEntrySnapshot(ctx, env, entryID, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := service.c.Entries.Get(ctx, env, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffEntrySnapshot(snapshot, entry)
+}
+
+// RestoreSnapshot writes the fields of the snapshot back to the entry. The
+// current version of the entry is fetched first, so the restore fails with a
+// version mismatch if the entry is changed concurrently. The restored entry is
+// left as a draft, publish it to make the restore visible in the delivery api.
+func (service *EntriesService) RestoreSnapshot(ctx context.Context, env *Environment, entryID, snapshotID string) (*Entry, error) {
+	snapshot, err := service.c.Snapshots.GetEntrySnapshot(ctx, env, entryID, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := service.Get(ctx, env, entryID)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Sys == nil || entry.Sys.ContentType == nil || entry.Sys.ContentType.Sys == nil {
+		return nil, fmt.Errorf("entry %s has no content type", entryID)
+	}
+
+	entry.Fields = snapshot.EntrySnapshotDetail.Fields
+	if err := service.Upsert(ctx, env, entry.Sys.ContentType.Sys.ID, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// localizedFields normalizes the fields to plain json values
+func localizedFields(fields map[string]any) (map[string]map[string]any, error) {
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+
+	localized := map[string]map[string]any{}
+	for field, value := range doc {
+		locales, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("field %s is not localized", field)
+		}
+		localized[field] = locales
+	}
+
+	return localized, nil
+}
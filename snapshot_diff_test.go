@@ -0,0 +1,133 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffEntryFields(t *testing.T) {
+	assertions := assert.New(t)
+
+	changes, err := DiffEntryFields(
+		map[string]any{
+			"title": map[string]string{"en-US": "Hello"},
+			"count": map[string]int{"en-US": 1},
+		},
+		map[string]any{
+			"title": map[string]any{"en-US": "Hello"},
+			"count": map[string]any{"en-US": 2},
+		},
+	)
+	assertions.Nil(err)
+	assertions.Equal([]FieldChange{
+		{Type: FieldChangeChanged, Field: "count", Locale: "en-US", From: float64(1), To: float64(2)},
+	}, changes)
+
+	_, err = DiffEntryFields(map[string]any{"title": "Hello"}, nil)
+	assertions.NotNil(err)
+}
+
+func TestDiffEntrySnapshots(t *testing.T) {
+	assertions := assert.New(t)
+
+	from, err := entrySnapshotFromTestData("snapshot_entry_1.json")
+	assertions.Nil(err)
+	to, err := entrySnapshotFromTestData("snapshot_entry_2.json")
+	assertions.Nil(err)
+
+	changes, err := DiffEntrySnapshots(from, to)
+	assertions.Nil(err)
+	assertions.Equal(4, len(changes))
+
+	assertions.Equal("removed body.en-US", changes[0].String())
+	assertions.Equal("Bacon is healthy!", changes[0].From)
+	assertions.Equal("added tags.en-US", changes[1].String())
+	assertions.Equal([]any{"bacon", "health"}, changes[1].To)
+	assertions.Equal("added title.de-DE", changes[2].String())
+	assertions.Equal("changed title.en-US", changes[3].String())
+	assertions.Equal("Hello, World!", changes[3].From)
+	assertions.Equal("Goodbye, World!", changes[3].To)
+
+	changes, err = DiffEntrySnapshots(from, from)
+	assertions.Nil(err)
+	assertions.Equal(0, len(changes))
+}
+
+func TestSnapshotsService_DiffEntry(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		checkHeaders(r, assertions)
+
+		switch r.URL.Path {
+		case "/spaces/" + spaceID + "/environments/" + environmentID + "/entries/5KsDBWseXY6QegucYAoacS/snapshots/dog":
+			_, _ = fmt.Fprintln(w, readTestData("snapshot_entry_2.json"))
+		case "/spaces/" + spaceID + "/environments/" + environmentID + "/entries/5KsDBWseXY6QegucYAoacS":
+			_, _ = fmt.Fprintln(w, readTestData("entry_1.json"))
+		default:
+			assertions.Fail("unexpected request", r.URL.Path)
+		}
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	changes, err := cma.Snapshots.DiffEntry(context.Background(), env, "5KsDBWseXY6QegucYAoacS", "dog")
+	assertions.Nil(err)
+	assertions.Equal(4, len(changes))
+	assertions.Equal("added body.en-US", changes[0].String())
+	assertions.Equal("removed tags.en-US", changes[1].String())
+}
+
+func TestEntriesService_RestoreSnapshot(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkHeaders(r, assertions)
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/spaces/"+spaceID+"/environments/"+environmentID+"/entries/5KsDBWseXY6QegucYAoacS/snapshots/dog":
+			_, _ = fmt.Fprintln(w, readTestData("snapshot_entry_2.json"))
+		case r.Method == "GET" && r.URL.Path == "/spaces/"+spaceID+"/environments/"+environmentID+"/entries/5KsDBWseXY6QegucYAoacS":
+			_, _ = fmt.Fprintln(w, readTestData("entry_1.json"))
+		case r.Method == "PUT" && r.URL.Path == "/spaces/"+spaceID+"/environments/"+environmentID+"/entries/5KsDBWseXY6QegucYAoacS":
+			assertions.Equal("1", r.Header.Get("X-Contentful-Version"))
+			assertions.Equal("hfM9RCJIk0wIm06WkEOQY", r.Header.Get("X-Contentful-Content-Type"))
+
+			var payload map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&payload)
+			assertions.Nil(err)
+			fields := payload["fields"].(map[string]interface{})
+			assertions.Equal("Goodbye, World!", fields["title"].(map[string]interface{})["en-US"])
+			assertions.Nil(fields["body"])
+
+			_ = json.NewEncoder(w).Encode(payload)
+		default:
+			assertions.Fail("unexpected request", r.Method+" "+r.URL.Path)
+		}
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	entry, err := cma.Entries.RestoreSnapshot(context.Background(), env, "5KsDBWseXY6QegucYAoacS", "dog")
+	assertions.Nil(err)
+	assertions.Equal("Hallo, Welt!", entry.Fields["title"].(map[string]interface{})["de-DE"])
+}
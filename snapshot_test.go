@@ -16,7 +16,7 @@ func TestSnapshotsService_ListEntrySnapshots(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/entries/hfM9RCJIk0wIm06WkEOQY/snapshots")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/entries/hfM9RCJIk0wIm06WkEOQY/snapshots")
 
 		checkHeaders(r, assertions)
 
@@ -32,7 +32,7 @@ func TestSnapshotsService_ListEntrySnapshots(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	collection, err := cma.Snapshots.ListEntrySnapshots(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", nil)
+	collection, err := cma.Snapshots.ListEntrySnapshots(context.Background(), env, "hfM9RCJIk0wIm06WkEOQY", nil)
 	assertions.Nil(err)
 	entrySnapshot := collection.Items
 	assertions.Equal(1, len(entrySnapshot))
@@ -45,7 +45,7 @@ func TestSnapshotsService_GetEntrySnapshot(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/entries/hfM9RCJIk0wIm06WkEOQY/snapshots/4FLrUHftHW3v2BLi9fzfjU")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/entries/hfM9RCJIk0wIm06WkEOQY/snapshots/4FLrUHftHW3v2BLi9fzfjU")
 
 		checkHeaders(r, assertions)
 
@@ -61,7 +61,7 @@ func TestSnapshotsService_GetEntrySnapshot(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	entrySnapshot, err := cma.Snapshots.GetEntrySnapshot(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", "4FLrUHftHW3v2BLi9fzfjU")
+	entrySnapshot, err := cma.Snapshots.GetEntrySnapshot(context.Background(), env, "hfM9RCJIk0wIm06WkEOQY", "4FLrUHftHW3v2BLi9fzfjU")
 	assertions.Nil(err)
 	assertions.Equal("Hello, World!", entrySnapshot.EntrySnapshotDetail.Fields["title"].(map[string]interface{})["en-US"])
 }
@@ -72,7 +72,7 @@ func TestSnapshotsService_GetEntrySnapshot_2(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/entries/hfM9RCJIk0wIm06WkEOQY/snapshots/4FLrUHftHW3v2BLi9fzfjU")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/entries/hfM9RCJIk0wIm06WkEOQY/snapshots/4FLrUHftHW3v2BLi9fzfjU")
 
 		checkHeaders(r, assertions)
 
@@ -88,8 +88,8 @@ func TestSnapshotsService_GetEntrySnapshot_2(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	_, err = cma.Snapshots.GetEntrySnapshot(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", "4FLrUHftHW3v2BLi9fzfjU")
-	assertions.Nil(err)
+	_, err = cma.Snapshots.GetEntrySnapshot(context.Background(), env, "hfM9RCJIk0wIm06WkEOQY", "4FLrUHftHW3v2BLi9fzfjU")
+	assertions.NotNil(err)
 }
 
 func TestSnapshotsService_ListContentTypeSnapshots(t *testing.T) {
@@ -98,7 +98,7 @@ func TestSnapshotsService_ListContentTypeSnapshots(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/content_types/hfM9RCJIk0wIm06WkEOQY/snapshots")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/content_types/hfM9RCJIk0wIm06WkEOQY/snapshots")
 
 		checkHeaders(r, assertions)
 
@@ -114,7 +114,7 @@ func TestSnapshotsService_ListContentTypeSnapshots(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	collection, err := cma.Snapshots.ListContentTypeSnapshots(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", nil)
+	collection, err := cma.Snapshots.ListContentTypeSnapshots(context.Background(), env, "hfM9RCJIk0wIm06WkEOQY", nil)
 	assertions.Nil(err)
 	entrySnapshot := collection.Items
 	assertions.Equal(1, len(entrySnapshot))
@@ -127,7 +127,7 @@ func TestSnapshotsService_GetContentTypeSnapshots(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/content_types/hfM9RCJIk0wIm06WkEOQY/snapshots/4FLrUHftHW3v2BLi9fzfjU")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/content_types/hfM9RCJIk0wIm06WkEOQY/snapshots/4FLrUHftHW3v2BLi9fzfjU")
 
 		checkHeaders(r, assertions)
 
@@ -143,7 +143,7 @@ func TestSnapshotsService_GetContentTypeSnapshots(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	entrySnapshot, err := cma.Snapshots.GetContentTypeSnapshots(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", "4FLrUHftHW3v2BLi9fzfjU")
+	entrySnapshot, err := cma.Snapshots.GetContentTypeSnapshots(context.Background(), env, "hfM9RCJIk0wIm06WkEOQY", "4FLrUHftHW3v2BLi9fzfjU")
 	assertions.Nil(err)
 	assertions.Equal("Blog Post", entrySnapshot.ContentTypeSnapshotDetail.Name)
 }
@@ -154,7 +154,7 @@ func TestSnapshotsService_GetContentTypeSnapshots_2(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/content_types/hfM9RCJIk0wIm06WkEOQY/snapshots/4FLrUHftHW3v2BLi9fzfjU")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/content_types/hfM9RCJIk0wIm06WkEOQY/snapshots/4FLrUHftHW3v2BLi9fzfjU")
 
 		checkHeaders(r, assertions)
 
@@ -170,6 +170,6 @@ func TestSnapshotsService_GetContentTypeSnapshots_2(t *testing.T) {
 	cma = NewCMA(CMAToken)
 	cma.BaseURL = server.URL
 
-	_, err = cma.Snapshots.GetContentTypeSnapshots(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", "4FLrUHftHW3v2BLi9fzfjU")
-	assertions.Nil(err)
+	_, err = cma.Snapshots.GetContentTypeSnapshots(context.Background(), env, "hfM9RCJIk0wIm06WkEOQY", "4FLrUHftHW3v2BLi9fzfjU")
+	assertions.NotNil(err)
 }
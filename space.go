@@ -64,8 +64,8 @@ func (service *SpacesService) Get(ctx context.Context, spaceID string) (*Space,
 	}
 
 	var space Space
-	if ok := service.c.do(req, &space); ok != nil {
-		return &Space{}, ok
+	if err := service.c.do(req, &space); err != nil {
+		return nil, err
 	}
 
 	return &space, nil
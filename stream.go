@@ -0,0 +1,351 @@
+package contentful
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// JSONDecoder decodes a stream of json values, *json.Decoder implements it
+type JSONDecoder interface {
+	Decode(v any) error
+	Token() (json.Token, error)
+	More() bool
+}
+
+// JSONCodec creates the decoders used for response bodies
+type JSONCodec interface {
+	NewDecoder(r io.Reader) JSONDecoder
+}
+
+// StdJSONCodec is the JSONCodec of encoding/json, used when no other codec is set
+type StdJSONCodec struct{}
+
+// NewDecoder returns a json.Decoder reading from r
+func (StdJSONCodec) NewDecoder(r io.Reader) JSONDecoder {
+	return json.NewDecoder(r)
+}
+
+// SetJSONCodec sets the codec used to decode responses, nil restores encoding/json
+func (c *Client) SetJSONCodec(codec JSONCodec) *Client {
+	c.codec = codec
+	return c
+}
+
+func (c *Client) jsonCodec() JSONCodec {
+	if c.codec == nil {
+		return StdJSONCodec{}
+	}
+
+	return c.codec
+}
+
+// Includes are the linked entries and assets the delivery API sends next to the items of a page
+type Includes struct {
+	Entry []Entry `json:"Entry,omitempty"`
+	Asset []Asset `json:"Asset,omitempty"`
+}
+
+// Iterator decodes the items of a collection one by one from the response
+// bodies, page after page, so only one item is held in memory at a time.
+// Responses are not cached and not coalesced.
+//
+//	it, err := cda.Entries.Iterate(ctx, env, query)
+//	if err != nil {
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		entry := it.Item()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type Iterator[T any] struct {
+	c     *Client
+	req   *http.Request
+	query *Query
+
+	onIncludes func(includes *Includes) error
+
+	res     *http.Response
+	dec     JSONDecoder
+	inItems bool
+
+	skip  int
+	limit int
+	total int
+	count int
+
+	item T
+	err  error
+	done bool
+}
+
+// newIterator initializes an iterator, no request is made before the first Next
+// if query is nil, order sys.createdAt
+func newIterator[T any](query *Query, client *Client, req *http.Request) *Iterator[T] {
+	if query == nil {
+		query = NewQuery()
+		query.Order("sys.createdAt", true)
+	} else {
+		query = query.Clone()
+	}
+
+	it := &Iterator[T]{
+		c:     client,
+		req:   req,
+		query: query,
+	}
+
+	if err := query.Validate(); err != nil {
+		it.err = err
+		it.done = true
+	}
+
+	return it
+}
+
+// OnIncludes calls fn with the includes of every page. The API sends the
+// includes after the items, so fn runs once the items of its page were
+// returned by Next. Without a handler includes are skipped, not decoded.
+func (it *Iterator[T]) OnIncludes(fn func(includes *Includes) error) *Iterator[T] {
+	it.onIncludes = fn
+	return it
+}
+
+// Next decodes the next item, it returns false when all items were read or an error occurred
+func (it *Iterator[T]) Next() bool {
+	for !it.done {
+		if it.dec == nil {
+			if err := it.open(); err != nil {
+				return it.fail(err)
+			}
+		}
+
+		if it.inItems && it.dec.More() {
+			var item T
+			if err := it.dec.Decode(&item); err != nil {
+				return it.fail(err)
+			}
+
+			it.item = item
+			it.count++
+			return true
+		}
+
+		if err := it.finishPage(); err != nil {
+			return it.fail(err)
+		}
+	}
+
+	return false
+}
+
+// Item returns the item decoded by the last Next
+func (it *Iterator[T]) Item() *T {
+	return &it.item
+}
+
+// Total returns the total of the collection, known once the first page was opened
+func (it *Iterator[T]) Total() int {
+	return it.total
+}
+
+// Err returns the error which stopped the iteration
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Close stops the iteration and closes the current response
+func (it *Iterator[T]) Close() error {
+	it.done = true
+	if it.res == nil {
+		return nil
+	}
+
+	err := it.res.Body.Close()
+	it.res = nil
+	it.dec = nil
+	return err
+}
+
+// Each calls fn with every item until fn returns an error
+func (it *Iterator[T]) Each(fn func(item *T) error) error {
+	defer it.Close()
+
+	for it.Next() {
+		if err := fn(it.Item()); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
+}
+
+func (it *Iterator[T]) fail(err error) bool {
+	it.err = err
+	it.Close()
+	return false
+}
+
+// open requests the page at it.skip and reads the response up to the first item
+func (it *Iterator[T]) open() error {
+	values, err := it.query.BuildValues()
+	if err != nil {
+		return err
+	}
+	values.Set("skip", strconv.Itoa(it.skip))
+
+	req := it.req.Clone(it.req.Context())
+	req.URL.RawQuery = values.Encode()
+
+	res, err := it.c.open(req)
+	if err != nil {
+		return err
+	}
+
+	it.res = res
+	it.dec = it.c.jsonCodec().NewDecoder(res.Body)
+	it.inItems = false
+	it.count = 0
+
+	if err := expectDelim(it.dec, '{'); err != nil {
+		return err
+	}
+
+	return it.readKeys(true)
+}
+
+// readKeys reads the keys of the page object. With stopAtItems it returns
+// inside the items array, otherwise it reads up to the end of the object.
+func (it *Iterator[T]) readKeys(stopAtItems bool) error {
+	for it.dec.More() {
+		token, err := it.dec.Token()
+		if err != nil {
+			return err
+		}
+
+		key, ok := token.(string)
+		if !ok {
+			return fmt.Errorf("unexpected json token %v", token)
+		}
+
+		switch key {
+		case "total":
+			err = it.dec.Decode(&it.total)
+		case "skip":
+			err = it.dec.Decode(&it.skip)
+		case "limit":
+			err = it.dec.Decode(&it.limit)
+		case "items":
+			if err := expectDelim(it.dec, '['); err != nil {
+				return err
+			}
+			it.inItems = true
+			if stopAtItems {
+				return nil
+			}
+			err = skipToEnd(it.dec)
+		case "includes":
+			if it.onIncludes == nil {
+				err = skipValue(it.dec)
+				break
+			}
+
+			var includes Includes
+			if err = it.dec.Decode(&includes); err == nil {
+				err = it.onIncludes(&includes)
+			}
+		default:
+			err = skipValue(it.dec)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(it.dec, '}')
+}
+
+// finishPage reads the rest of the page and moves to the next one
+func (it *Iterator[T]) finishPage() error {
+	if it.inItems {
+		if err := expectDelim(it.dec, ']'); err != nil {
+			return err
+		}
+		it.inItems = false
+	}
+
+	if err := it.readKeys(false); err != nil {
+		return err
+	}
+
+	if err := it.res.Body.Close(); err != nil {
+		return err
+	}
+	it.res = nil
+	it.dec = nil
+
+	limit := it.limit
+	if limit <= 0 {
+		limit = it.count
+	}
+
+	if it.count == 0 || it.skip+limit >= it.total {
+		it.done = true
+		return nil
+	}
+
+	it.skip += limit
+	return nil
+}
+
+// expectDelim reads the next token and checks it is the given delimiter
+func expectDelim(dec JSONDecoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != delim {
+		return fmt.Errorf("expected json %s, got %v", delim, token)
+	}
+
+	return nil
+}
+
+// skipValue reads the next value token by token without holding it in memory
+func skipValue(dec JSONDecoder) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	switch token {
+	case json.Delim('{'), json.Delim('['):
+		return skipToEnd(dec)
+	default:
+		return nil
+	}
+}
+
+// skipToEnd reads up to the end of the array or object opened last
+func skipToEnd(dec JSONDecoder) error {
+	for depth := 1; depth > 0; {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		switch token {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntriesService_Iterate(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	server := pagedServer(assertions, 250, func(int) int { return 200 })
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	it, err := cma.Entries.Iterate(context.Background(), env, NewQuery().Limit(100))
+	assertions.Nil(err)
+	defer it.Close()
+
+	ids := []string{}
+	for it.Next() {
+		ids = append(ids, it.Item().Sys.ID)
+	}
+	assertions.Nil(it.Err())
+	assertions.Equal(250, it.Total())
+	assertions.Equal(250, len(ids))
+	assertions.Equal("e0", ids[0])
+	assertions.Equal("e249", ids[249])
+	assertions.False(it.Next())
+}
+
+func TestEntriesService_IterateIncludes(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/"+environmentID+"/entries")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, `{
+			"sys": {"type": "Array"},
+			"total": 2, "skip": 0, "limit": 100,
+			"items": [
+				{"sys": {"id": "a", "type": "Entry"}, "fields": {"author": {"sys": {"type": "Link", "linkType": "Entry", "id": "author"}}}},
+				{"sys": {"id": "b", "type": "Entry"}, "fields": {"tags": [["nested"], {"deep": [1, 2]}]}}
+			],
+			"errors": [{"sys": {"id": "notResolvable", "type": "error"}}],
+			"includes": {
+				"Entry": [{"sys": {"id": "author", "type": "Entry"}, "fields": {"name": "Jane"}}],
+				"Asset": [{"sys": {"id": "photo", "type": "Asset"}}]
+			}
+		}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	it, err := cma.Entries.Iterate(context.Background(), env, nil)
+	assertions.Nil(err)
+
+	var includes *Includes
+	ids := []string{}
+	err = it.OnIncludes(func(page *Includes) error {
+		assertions.Equal(2, len(ids))
+		includes = page
+		return nil
+	}).Each(func(entry *Entry) error {
+		ids = append(ids, entry.Sys.ID)
+		return nil
+	})
+	assertions.Nil(err)
+	assertions.Equal([]string{"a", "b"}, ids)
+	assertions.Equal("author", includes.Entry[0].Sys.ID)
+	assertions.Equal("Jane", includes.Entry[0].Fields["name"])
+	assertions.Equal("photo", includes.Asset[0].Sys.ID)
+
+	// without a handler the includes are skipped
+	it, err = cma.Entries.Iterate(context.Background(), env, nil)
+	assertions.Nil(err)
+	count := 0
+	err = it.Each(func(entry *Entry) error {
+		count++
+		return nil
+	})
+	assertions.Nil(err)
+	assertions.Equal(2, count)
+
+	// the callback stops the iteration
+	it, err = cma.Entries.Iterate(context.Background(), env, nil)
+	assertions.Nil(err)
+	stop := errors.New("stop")
+	err = it.Each(func(entry *Entry) error {
+		return stop
+	})
+	assertions.Equal(stop, err)
+}
+
+func TestAssetsService_IterateErrors(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	server := pagedServer(assertions, 250, func(skip int) int {
+		if skip == 100 {
+			return 404
+		}
+		return 200
+	})
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	it, err := cma.Assets.Iterate(context.Background(), env, NewQuery().Limit(100))
+	assertions.Nil(err)
+
+	count := 0
+	for it.Next() {
+		count++
+	}
+	assertions.Equal(100, count)
+	var notFound NotFoundError
+	assertions.True(errors.As(it.Err(), &notFound))
+
+	// invalid queries fail without a request
+	it, err = cma.Assets.Iterate(context.Background(), env, NewQuery().Limit(3000))
+	assertions.Nil(err)
+	assertions.False(it.Next())
+	assertions.NotNil(it.Err())
+}
+
+// countingCodec counts the decoders created by the client
+type countingCodec struct {
+	decoders int
+}
+
+func (codec *countingCodec) NewDecoder(r io.Reader) JSONDecoder {
+	codec.decoders++
+	return StdJSONCodec{}.NewDecoder(r)
+}
+
+func TestClient_SetJSONCodec(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	server := pagedServer(assertions, 150, func(int) int { return 200 })
+	defer server.Close()
+
+	codec := &countingCodec{}
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+	cma.SetJSONCodec(codec)
+
+	_, err = cma.Entries.List(context.Background(), env, NewQuery().Limit(100))
+	assertions.Nil(err)
+	assertions.Equal(1, codec.decoders)
+
+	it, err := cma.Entries.Iterate(context.Background(), env, NewQuery().Limit(100))
+	assertions.Nil(err)
+	err = it.Each(func(entry *Entry) error { return nil })
+	assertions.Nil(err)
+	assertions.Equal(3, codec.decoders)
+
+	cma.SetJSONCodec(nil)
+	_, err = cma.Entries.List(context.Background(), env, NewQuery().Limit(100))
+	assertions.Nil(err)
+	assertions.Equal(3, codec.decoders)
+}
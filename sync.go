@@ -0,0 +1,168 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// SyncService service
+type SyncService service
+
+// Sync item types
+const (
+	SyncItemTypeEntry        = "Entry"
+	SyncItemTypeAsset        = "Asset"
+	SyncItemTypeDeletedEntry = "DeletedEntry"
+	SyncItemTypeDeletedAsset = "DeletedAsset"
+)
+
+// SyncItem is an entity or a deletion of a sync response, the fields hold
+// the values of all locales
+type SyncItem struct {
+	Sys *Sys
+	raw json.RawMessage
+}
+
+// UnmarshalJSON for custom json unmarshaling
+func (item *SyncItem) UnmarshalJSON(data []byte) error {
+	var payload struct {
+		Sys *Sys `json:"sys"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	item.Sys = payload.Sys
+	item.raw = append(json.RawMessage{}, data...)
+	return nil
+}
+
+// MarshalJSON for custom json marshaling
+func (item SyncItem) MarshalJSON() ([]byte, error) {
+	if item.raw != nil {
+		return item.raw, nil
+	}
+
+	return json.Marshal(map[string]any{"sys": item.Sys})
+}
+
+// Type returns the sys type of the item
+func (item *SyncItem) Type() string {
+	if item.Sys == nil {
+		return ""
+	}
+
+	return item.Sys.Type
+}
+
+// Entry returns the item as an entry
+func (item *SyncItem) Entry() (*Entry, error) {
+	if item.Type() != SyncItemTypeEntry {
+		return nil, fmt.Errorf("sync item %s is not an entry", item.Type())
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(item.raw, &entry); err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// Asset returns the item as an asset
+func (item *SyncItem) Asset() (*Asset, error) {
+	if item.Type() != SyncItemTypeAsset {
+		return nil, fmt.Errorf("sync item %s is not an asset", item.Type())
+	}
+
+	var asset Asset
+	if err := json.Unmarshal(item.raw, &asset); err != nil {
+		return nil, err
+	}
+
+	return &asset, nil
+}
+
+// SyncResult holds the items of all pages of a sync and the token of the next sync
+type SyncResult struct {
+	Items     []SyncItem
+	SyncToken string
+}
+
+type syncPage struct {
+	Items       []SyncItem `json:"items"`
+	NextPageURL string     `json:"nextPageUrl"`
+	NextSyncURL string     `json:"nextSyncUrl"`
+}
+
+// Initial syncs all published entries and assets of the environment.
+// https://www.contentful.com/developers/docs/references/content-delivery-api/#/reference/synchronization
+func (service *SyncService) Initial(ctx context.Context, env *Environment) (*SyncResult, error) {
+	query := url.Values{}
+	query.Set("initial", "true")
+
+	return service.sync(ctx, env, query)
+}
+
+// Delta syncs the changes since the sync which returned the token
+func (service *SyncService) Delta(ctx context.Context, env *Environment, syncToken string) (*SyncResult, error) {
+	query := url.Values{}
+	query.Set("sync_token", syncToken)
+
+	return service.sync(ctx, env, query)
+}
+
+// sync fetches all pages of a sync
+func (service *SyncService) sync(ctx context.Context, env *Environment, query url.Values) (*SyncResult, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/sync", env.Sys.Space.Sys.ID, env.Sys.ID)
+
+	result := &SyncResult{Items: []SyncItem{}}
+	for {
+		req, err := service.c.newRequest(ctx, http.MethodGet, path, query, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page syncPage
+		if err := service.c.do(req, &page); err != nil {
+			return nil, err
+		}
+		result.Items = append(result.Items, page.Items...)
+
+		if page.NextPageURL == "" {
+			token, err := syncToken(page.NextSyncURL)
+			if err != nil {
+				return nil, err
+			}
+
+			result.SyncToken = token
+			return result, nil
+		}
+
+		token, err := syncToken(page.NextPageURL)
+		if err != nil {
+			return nil, err
+		}
+
+		query = url.Values{}
+		query.Set("sync_token", token)
+	}
+}
+
+// syncToken returns the sync_token of a next page or next sync url
+func syncToken(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	token := u.Query().Get("sync_token")
+	if token == "" {
+		return "", fmt.Errorf("sync url %q has no sync token", rawURL)
+	}
+
+	return token, nil
+}
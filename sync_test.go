@@ -0,0 +1,84 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncService_Initial(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+spaceID+"/environments/"+environmentID+"/sync", r.URL.Path)
+		assertions.Equal("Bearer "+CDAToken, r.Header.Get("Authorization"))
+
+		w.WriteHeader(200)
+		if r.URL.Query().Get("initial") == "true" {
+			_, _ = fmt.Fprintln(w, readTestData("sync_initial.json"))
+			return
+		}
+
+		assertions.Equal("page-2", r.URL.Query().Get("sync_token"))
+		_, _ = fmt.Fprintln(w, readTestData("sync_initial_2.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cda client
+	cda := NewCDA(CDAToken)
+	cda.BaseURL = server.URL
+
+	result, err := cda.Sync.Initial(context.Background(), env)
+	assertions.Nil(err)
+	assertions.Equal("delta-1", result.SyncToken)
+	assertions.Equal(4, len(result.Items))
+
+	entry, err := result.Items[1].Entry()
+	assertions.Nil(err)
+	assertions.Equal("p1", entry.Sys.ID)
+	assertions.Equal(map[string]any{"en-US": "Running Shoe", "de-DE": "Laufschuh"}, entry.Fields["title"])
+
+	_, err = result.Items[1].Asset()
+	assertions.NotNil(err)
+
+	asset, err := result.Items[3].Asset()
+	assertions.Nil(err)
+	assertions.Equal("photo.jpg", asset.Fields.File.Map["en-US"].FileName)
+}
+
+func TestSyncService_Delta(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("delta-1", r.URL.Query().Get("sync_token"))
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("sync_delta.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cda client
+	cda := NewCDA(CDAToken)
+	cda.BaseURL = server.URL
+
+	result, err := cda.Sync.Delta(context.Background(), env, "delta-1")
+	assertions.Nil(err)
+	assertions.Equal("delta-2", result.SyncToken)
+	assertions.Equal(SyncItemTypeEntry, result.Items[0].Type())
+	assertions.Equal(SyncItemTypeDeletedEntry, result.Items[1].Type())
+	assertions.Equal("p2", result.Items[1].Sys.ID)
+}
@@ -0,0 +1,118 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// TeamsService service
+type TeamsService service
+
+// Team model
+type Team struct {
+	Sys         *Sys   `json:"sys,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	MemberCount int    `json:"memberCount,omitempty"`
+}
+
+// MarshalJSON for custom json marshaling
+func (team *Team) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Name        string `json:"name"`
+		Description string `json:"description,omitempty"`
+	}{
+		Name:        team.Name,
+		Description: team.Description,
+	})
+}
+
+// GetVersion returns entity version
+func (team *Team) GetVersion() int {
+	version := 1
+	if team.Sys != nil {
+		version = team.Sys.Version
+	}
+
+	return version
+}
+
+// List returns a teams collection
+func (service *TeamsService) List(ctx context.Context, organizationID string, query *Query) (*Collection[Team], error) {
+	path := fmt.Sprintf("/organizations/%s/teams", organizationID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := newCollection[Team](query, service.c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return col, nil
+}
+
+// Get returns a single team
+func (service *TeamsService) Get(ctx context.Context, organizationID, teamID string) (*Team, error) {
+	path := fmt.Sprintf("/organizations/%s/teams/%s", organizationID, teamID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var team Team
+	if err := service.c.do(req, &team); err != nil {
+		return nil, err
+	}
+
+	return &team, nil
+}
+
+// Upsert updates or creates a new team
+func (service *TeamsService) Upsert(ctx context.Context, organizationID string, team *Team) error {
+	bytesArray, err := json.Marshal(team)
+	if err != nil {
+		return err
+	}
+
+	var path string
+	var method string
+
+	if team.Sys != nil && team.Sys.ID != "" {
+		path = fmt.Sprintf("/organizations/%s/teams/%s", organizationID, team.Sys.ID)
+		method = http.MethodPut
+	} else {
+		path = fmt.Sprintf("/organizations/%s/teams", organizationID)
+		method = http.MethodPost
+	}
+
+	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(team.GetVersion()))
+
+	return service.c.do(req, team)
+}
+
+// Delete the team
+func (service *TeamsService) Delete(ctx context.Context, organizationID string, team *Team) error {
+	path := fmt.Sprintf("/organizations/%s/teams/%s", organizationID, team.Sys.ID)
+
+	req, err := service.c.newRequest(ctx, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(team.GetVersion()))
+
+	return service.c.do(req, nil)
+}
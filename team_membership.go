@@ -0,0 +1,82 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// TeamMembershipsService service
+type TeamMembershipsService service
+
+// TeamMembership model
+type TeamMembership struct {
+	Sys                      *Sys   `json:"sys,omitempty"`
+	Admin                    bool   `json:"admin"`
+	OrganizationMembershipID string `json:"organizationMembershipId"`
+}
+
+// List returns the memberships of a team
+func (service *TeamMembershipsService) List(ctx context.Context, organizationID, teamID string, query *Query) (*Collection[TeamMembership], error) {
+	path := fmt.Sprintf("/organizations/%s/teams/%s/team_memberships", organizationID, teamID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := newCollection[TeamMembership](query, service.c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return col, nil
+}
+
+// ListAll returns the memberships of all teams of the organization
+func (service *TeamMembershipsService) ListAll(ctx context.Context, organizationID string, query *Query) (*Collection[TeamMembership], error) {
+	path := fmt.Sprintf("/organizations/%s/team_memberships", organizationID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := newCollection[TeamMembership](query, service.c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return col, nil
+}
+
+// Create adds an organization member to the team
+func (service *TeamMembershipsService) Create(ctx context.Context, organizationID, teamID string, membership *TeamMembership) error {
+	bytesArray, err := json.Marshal(membership)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/organizations/%s/teams/%s/team_memberships", organizationID, teamID)
+
+	req, err := service.c.newRequest(ctx, http.MethodPost, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, membership)
+}
+
+// Delete removes a member from the team
+func (service *TeamMembershipsService) Delete(ctx context.Context, organizationID, teamID, membershipID string) error {
+	path := fmt.Sprintf("/organizations/%s/teams/%s/team_memberships/%s", organizationID, teamID, membershipID)
+
+	req, err := service.c.newRequest(ctx, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, nil)
+}
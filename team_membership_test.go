@@ -0,0 +1,123 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamMembershipsService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/organizations/"+organizationID+"/teams/team-1/team_memberships")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("team_membership.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	collection, err := cma.TeamMemberships.List(context.Background(), organizationID, "team-1", nil)
+	assertions.Nil(err)
+	memberships := collection.Items
+	assertions.Equal(1, len(memberships))
+	assertions.Equal("org-membership-1", memberships[0].OrganizationMembershipID)
+	assertions.Equal("team-1", memberships[0].Sys.Team.Sys.ID)
+}
+
+func TestTeamMembershipsService_ListAll(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/organizations/"+organizationID+"/team_memberships")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("team_membership.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	collection, err := cma.TeamMemberships.ListAll(context.Background(), organizationID, nil)
+	assertions.Nil(err)
+	assertions.Equal(1, len(collection.Items))
+}
+
+func TestTeamMembershipsService_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		assertions.Equal(r.RequestURI, "/organizations/"+organizationID+"/teams/team-1/team_memberships")
+
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("org-membership-1", payload["organizationMembershipId"])
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, readTestData("team_membership_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	membership := &TeamMembership{OrganizationMembershipID: "org-membership-1"}
+
+	err := cma.TeamMemberships.Create(context.Background(), organizationID, "team-1", membership)
+	assertions.Nil(err)
+	assertions.Equal("team-membership-1", membership.Sys.ID)
+}
+
+func TestTeamMembershipsService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "DELETE")
+		assertions.Equal(r.RequestURI, "/organizations/"+organizationID+"/teams/team-1/team_memberships/team-membership-1")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(204)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	err := cma.TeamMemberships.Delete(context.Background(), organizationID, "team-1", "team-membership-1")
+	assertions.Nil(err)
+}
@@ -0,0 +1,111 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// TeamSpaceMembershipsService service
+type TeamSpaceMembershipsService service
+
+// TeamSpaceMembership model, grants every member of a team access to a space
+type TeamSpaceMembership struct {
+	Sys   *Sys    `json:"sys,omitempty"`
+	Admin bool    `json:"admin"`
+	Roles []Roles `json:"roles"`
+}
+
+// GetVersion returns entity version
+func (membership *TeamSpaceMembership) GetVersion() int {
+	version := 1
+	if membership.Sys != nil {
+		version = membership.Sys.Version
+	}
+
+	return version
+}
+
+// List returns the team memberships of a space
+func (service *TeamSpaceMembershipsService) List(ctx context.Context, spaceID string, query *Query) (*Collection[TeamSpaceMembership], error) {
+	path := fmt.Sprintf("/spaces/%s/team_space_memberships", spaceID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := newCollection[TeamSpaceMembership](query, service.c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return col, nil
+}
+
+// Get returns a single team space membership
+func (service *TeamSpaceMembershipsService) Get(ctx context.Context, spaceID, membershipID string) (*TeamSpaceMembership, error) {
+	path := fmt.Sprintf("/spaces/%s/team_space_memberships/%s", spaceID, membershipID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var membership TeamSpaceMembership
+	if err := service.c.do(req, &membership); err != nil {
+		return nil, err
+	}
+
+	return &membership, nil
+}
+
+// Upsert updates or creates a team space membership for the team
+func (service *TeamSpaceMembershipsService) Upsert(ctx context.Context, spaceID, teamID string, membership *TeamSpaceMembership) error {
+	bytesArray, err := json.Marshal(&struct {
+		Admin bool    `json:"admin"`
+		Roles []Roles `json:"roles"`
+	}{
+		Admin: membership.Admin,
+		Roles: membership.Roles,
+	})
+	if err != nil {
+		return err
+	}
+
+	var path string
+	var method string
+
+	if membership.Sys != nil && membership.Sys.ID != "" {
+		path = fmt.Sprintf("/spaces/%s/team_space_memberships/%s", spaceID, membership.Sys.ID)
+		method = http.MethodPut
+	} else {
+		path = fmt.Sprintf("/spaces/%s/team_space_memberships", spaceID)
+		method = http.MethodPost
+	}
+
+	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Team", teamID)
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(membership.GetVersion()))
+
+	return service.c.do(req, membership)
+}
+
+// Delete the team space membership
+func (service *TeamSpaceMembershipsService) Delete(ctx context.Context, spaceID, membershipID string) error {
+	path := fmt.Sprintf("/spaces/%s/team_space_memberships/%s", spaceID, membershipID)
+
+	req, err := service.c.newRequest(ctx, http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, nil)
+}
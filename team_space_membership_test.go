@@ -0,0 +1,157 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamSpaceMembershipsService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/team_space_memberships")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("team_space_membership.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	collection, err := cma.TeamSpaceMemberships.List(context.Background(), spaceID, nil)
+	assertions.Nil(err)
+	memberships := collection.Items
+	assertions.Equal(1, len(memberships))
+	assertions.Equal("1ElgCn1mi1UHSBLTP2v4TD", memberships[0].Roles[0].Sys.ID)
+}
+
+func TestTeamSpaceMembershipsService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/team_space_memberships/team-space-membership-1")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("team_space_membership_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	membership, err := cma.TeamSpaceMemberships.Get(context.Background(), spaceID, "team-space-membership-1")
+	assertions.Nil(err)
+	assertions.Equal("team-1", membership.Sys.Team.Sys.ID)
+}
+
+func TestTeamSpaceMembershipsService_Upsert_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/team_space_memberships")
+		assertions.Equal("team-1", r.Header.Get("X-Contentful-Team"))
+
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal(false, payload["admin"])
+		assertions.Equal(1, len(payload["roles"].([]interface{})))
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, readTestData("team_space_membership_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	membership := &TeamSpaceMembership{
+		Roles: roleLinks([]string{"1ElgCn1mi1UHSBLTP2v4TD"}),
+	}
+
+	err := cma.TeamSpaceMemberships.Upsert(context.Background(), spaceID, "team-1", membership)
+	assertions.Nil(err)
+	assertions.Equal("team-space-membership-1", membership.Sys.ID)
+}
+
+func TestTeamSpaceMembershipsService_Upsert_Update(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "PUT")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/team_space_memberships/team-space-membership-1")
+		assertions.Equal("1", r.Header.Get("X-Contentful-Version"))
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("team_space_membership_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	membership := &TeamSpaceMembership{
+		Sys:   &Sys{ID: "team-space-membership-1", Version: 1},
+		Admin: true,
+	}
+
+	err := cma.TeamSpaceMemberships.Upsert(context.Background(), spaceID, "team-1", membership)
+	assertions.Nil(err)
+}
+
+func TestTeamSpaceMembershipsService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "DELETE")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/team_space_memberships/team-space-membership-1")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(204)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	err := cma.TeamSpaceMemberships.Delete(context.Background(), spaceID, "team-space-membership-1")
+	assertions.Nil(err)
+}
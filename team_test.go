@@ -0,0 +1,157 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamsService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/organizations/"+organizationID+"/teams")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("team.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	collection, err := cma.Teams.List(context.Background(), organizationID, nil)
+	assertions.Nil(err)
+	teams := collection.Items
+	assertions.Equal(1, len(teams))
+	assertions.Equal("Editors", teams[0].Name)
+	assertions.Equal(3, teams[0].MemberCount)
+}
+
+func TestTeamsService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/organizations/"+organizationID+"/teams/team-1")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("team_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	team, err := cma.Teams.Get(context.Background(), organizationID, "team-1")
+	assertions.Nil(err)
+	assertions.Equal("Editorial team", team.Description)
+}
+
+func TestTeamsService_Upsert_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		assertions.Equal(r.RequestURI, "/organizations/"+organizationID+"/teams")
+
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal(map[string]interface{}{"name": "Editors", "description": "Editorial team"}, payload)
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, readTestData("team_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	team := &Team{
+		Name:        "Editors",
+		Description: "Editorial team",
+	}
+
+	err := cma.Teams.Upsert(context.Background(), organizationID, team)
+	assertions.Nil(err)
+	assertions.Equal("team-1", team.Sys.ID)
+}
+
+func TestTeamsService_Upsert_Update(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "PUT")
+		assertions.Equal(r.RequestURI, "/organizations/"+organizationID+"/teams/team-1")
+		assertions.Equal("1", r.Header.Get("X-Contentful-Version"))
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("team_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	team := &Team{
+		Sys:  &Sys{ID: "team-1", Version: 1},
+		Name: "Editors",
+	}
+
+	err := cma.Teams.Upsert(context.Background(), organizationID, team)
+	assertions.Nil(err)
+}
+
+func TestTeamsService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "DELETE")
+		assertions.Equal(r.RequestURI, "/organizations/"+organizationID+"/teams/team-1")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(204)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	err := cma.Teams.Delete(context.Background(), organizationID, &Team{Sys: &Sys{ID: "team-1", Version: 1}})
+	assertions.Nil(err)
+}
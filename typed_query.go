@@ -0,0 +1,588 @@
+package contentful
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// noinspection GoUnusedConst
+const (
+	QueryOperatorEqual              = ""
+	QueryOperatorNotEqual           = "ne"
+	QueryOperatorAll                = "all"
+	QueryOperatorIn                 = "in"
+	QueryOperatorNotIn              = "nin"
+	QueryOperatorExists             = "exists"
+	QueryOperatorLessThan           = "lt"
+	QueryOperatorLessThanOrEqual    = "lte"
+	QueryOperatorGreaterThan        = "gt"
+	QueryOperatorGreaterThanOrEqual = "gte"
+	QueryOperatorMatch              = "match"
+	QueryOperatorNear               = "near"
+	QueryOperatorWithin             = "within"
+)
+
+// sysQueryFields are the sys attributes of an entry which can be queried
+var sysQueryFields = map[string]string{
+	"sys.id":                 FieldTypeSymbol,
+	"sys.type":               FieldTypeSymbol,
+	"sys.createdAt":          FieldTypeDate,
+	"sys.updatedAt":          FieldTypeDate,
+	"sys.publishedAt":        FieldTypeDate,
+	"sys.firstPublishedAt":   FieldTypeDate,
+	"sys.archivedAt":         FieldTypeDate,
+	"sys.version":            FieldTypeInteger,
+	"sys.revision":           FieldTypeInteger,
+	"sys.publishedVersion":   FieldTypeInteger,
+	"sys.publishedCounter":   FieldTypeInteger,
+	"sys.archivedVersion":    FieldTypeInteger,
+	"sys.contentType.sys.id": FieldTypeSymbol,
+	"sys.createdBy.sys.id":   FieldTypeSymbol,
+	"sys.updatedBy.sys.id":   FieldTypeSymbol,
+}
+
+// queryOperatorTypes lists the field types each operator can be used on
+var queryOperatorTypes = map[string][]string{
+	QueryOperatorEqual:              {FieldTypeSymbol, FieldTypeText, FieldTypeInteger, FieldTypeNumber, FieldTypeBoolean, FieldTypeDate, FieldTypeArray},
+	QueryOperatorNotEqual:           {FieldTypeSymbol, FieldTypeText, FieldTypeInteger, FieldTypeNumber, FieldTypeBoolean, FieldTypeDate, FieldTypeArray},
+	QueryOperatorIn:                 {FieldTypeSymbol, FieldTypeText, FieldTypeInteger, FieldTypeNumber, FieldTypeDate, FieldTypeArray},
+	QueryOperatorNotIn:              {FieldTypeSymbol, FieldTypeText, FieldTypeInteger, FieldTypeNumber, FieldTypeDate, FieldTypeArray},
+	QueryOperatorAll:                {FieldTypeArray},
+	QueryOperatorLessThan:           {FieldTypeInteger, FieldTypeNumber, FieldTypeDate},
+	QueryOperatorLessThanOrEqual:    {FieldTypeInteger, FieldTypeNumber, FieldTypeDate},
+	QueryOperatorGreaterThan:        {FieldTypeInteger, FieldTypeNumber, FieldTypeDate},
+	QueryOperatorGreaterThanOrEqual: {FieldTypeInteger, FieldTypeNumber, FieldTypeDate},
+	QueryOperatorMatch:              {FieldTypeSymbol, FieldTypeText, FieldTypeRichText, FieldTypeArray},
+	QueryOperatorNear:               {FieldTypeLocation},
+	QueryOperatorWithin:             {FieldTypeLocation},
+	"order":                         {FieldTypeSymbol, FieldTypeInteger, FieldTypeNumber, FieldTypeBoolean, FieldTypeDate},
+}
+
+// queryField is a resolved field path of a typed query
+type queryField struct {
+	path string
+	// typ is the field type, empty for paths inside Object fields
+	typ string
+	// itemType is the type of the array items
+	itemType string
+}
+
+// valueType returns the type single values of the field are formatted as
+func (f queryField) valueType() string {
+	if f.typ == FieldTypeArray {
+		return f.itemType
+	}
+
+	return f.typ
+}
+
+// TypedQuery builds entry queries checked against a content type. Field paths
+// are validated, operators are checked against the field type and values are
+// formatted for the type. Every method returns a new query, the receiver is
+// never modified, so queries can be shared and extended safely.
+//
+// The first problem is recorded and returned by Err, Build and Values.
+type TypedQuery struct {
+	contentType *ContentType
+	query       *Query
+	err         error
+}
+
+// NewTypedQuery returns a query for the entries of the content type
+func NewTypedQuery(contentType *ContentType) *TypedQuery {
+	q := &TypedQuery{contentType: contentType, query: NewQuery()}
+
+	if contentType == nil || contentType.Sys == nil || contentType.Sys.ID == "" {
+		q.err = QueryBuildError{Reason: "content type has no id"}
+		return q
+	}
+
+	q.query.ContentType(contentType.Sys.ID)
+
+	return q
+}
+
+// Clone returns a copy of the query
+func (q *TypedQuery) Clone() *TypedQuery {
+	return &TypedQuery{contentType: q.contentType, query: q.query.Clone(), err: q.err}
+}
+
+// Err returns the first error of the query
+func (q *TypedQuery) Err() error {
+	return q.err
+}
+
+// Build returns a copy of the built query for the List methods of the services
+func (q *TypedQuery) Build() (*Query, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+
+	query := q.query.Clone()
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	return query, nil
+}
+
+// Values returns the url values of the query
+func (q *TypedQuery) Values() (url.Values, error) {
+	query, err := q.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return query.BuildValues()
+}
+
+// with returns a copy of the query changed by fn, errors stop further changes
+func (q *TypedQuery) with(fn func(clone *TypedQuery) error) *TypedQuery {
+	if q.err != nil {
+		return q
+	}
+
+	clone := q.Clone()
+	if err := fn(clone); err != nil {
+		clone.err = err
+	}
+
+	return clone
+}
+
+// filter resolves the field, checks the operator and formats the values
+func (q *TypedQuery) filter(field, operator string, values ...any) *TypedQuery {
+	return q.with(func(clone *TypedQuery) error {
+		f, err := clone.resolve(field, operator)
+		if err != nil {
+			return err
+		}
+
+		formatted := make([]string, 0, len(values))
+		for _, value := range values {
+			str, err := formatQueryValue(f.valueType(), value)
+			if err != nil {
+				return QueryBuildError{Field: f.path, Operator: operator, Reason: err.Error()}
+			}
+			formatted = append(formatted, str)
+		}
+
+		key := f.path
+		if operator != QueryOperatorEqual {
+			key += "[" + operator + "]"
+		}
+		clone.query.Param(key, strings.Join(formatted, ","))
+
+		return nil
+	})
+}
+
+// resolve returns the field of the path and checks that the operator suits its type
+func (q *TypedQuery) resolve(path, operator string) (queryField, error) {
+	f, err := q.resolvePath(path)
+	if err != nil {
+		return f, QueryBuildError{Field: path, Operator: operator, Reason: err.Error()}
+	}
+
+	if operator == QueryOperatorExists || f.typ == "" {
+		return f, nil
+	}
+
+	for _, t := range queryOperatorTypes[operator] {
+		if t == f.typ {
+			if operator == QueryOperatorMatch && f.typ == FieldTypeArray && f.itemType != FieldTypeSymbol {
+				break
+			}
+			return f, nil
+		}
+	}
+
+	return f, QueryBuildError{Field: f.path, Operator: operator, Reason: "operator is not supported for " + f.typ + " fields"}
+}
+
+// resolvePath resolves sys.* and fields.* paths, the fields. prefix is optional
+func (q *TypedQuery) resolvePath(path string) (queryField, error) {
+	if strings.HasPrefix(path, "sys.") {
+		typ, ok := sysQueryFields[path]
+		if !ok {
+			return queryField{}, fmt.Errorf("unknown sys attribute")
+		}
+
+		return queryField{path: path, typ: typ}, nil
+	}
+
+	segments := strings.Split(strings.TrimPrefix(path, "fields."), ".")
+
+	field := q.field(segments[0])
+	if field == nil {
+		return queryField{}, fmt.Errorf("field %s does not exist in content type %s", segments[0], q.contentType.Sys.ID)
+	}
+
+	resolved := queryField{path: "fields." + strings.Join(segments, "."), typ: field.Type}
+	if field.Items != nil {
+		resolved.itemType = field.Items.Type
+	}
+
+	if len(segments) == 1 {
+		return resolved, nil
+	}
+
+	rest := strings.Join(segments[1:], ".")
+	switch {
+	case field.Type == FieldTypeObject:
+		resolved.typ = ""
+	case (field.Type == FieldTypeLink || resolved.itemType == FieldTypeLink) && rest == "sys.id":
+		resolved.typ = FieldTypeSymbol
+	default:
+		return queryField{}, fmt.Errorf("%s fields have no attribute %s", field.Type, rest)
+	}
+
+	return resolved, nil
+}
+
+// Equal matches entries whose field equals the value
+func (q *TypedQuery) Equal(field string, value any) *TypedQuery {
+	return q.filter(field, QueryOperatorEqual, value)
+}
+
+// NotEqual [ne] matches entries whose field does not equal the value
+func (q *TypedQuery) NotEqual(field string, value any) *TypedQuery {
+	return q.filter(field, QueryOperatorNotEqual, value)
+}
+
+// In [in] matches entries whose field equals one of the values
+func (q *TypedQuery) In(field string, values ...any) *TypedQuery {
+	return q.filter(field, QueryOperatorIn, values...)
+}
+
+// NotIn [nin] matches entries whose field equals none of the values
+func (q *TypedQuery) NotIn(field string, values ...any) *TypedQuery {
+	return q.filter(field, QueryOperatorNotIn, values...)
+}
+
+// All [all] matches entries whose array field contains all values
+func (q *TypedQuery) All(field string, values ...any) *TypedQuery {
+	return q.filter(field, QueryOperatorAll, values...)
+}
+
+// Exists [exists] matches entries which have or do not have a value for the field
+func (q *TypedQuery) Exists(field string, exists bool) *TypedQuery {
+	return q.with(func(clone *TypedQuery) error {
+		f, err := clone.resolve(field, QueryOperatorExists)
+		if err != nil {
+			return err
+		}
+
+		clone.query.Param(f.path+"[exists]", strconv.FormatBool(exists))
+
+		return nil
+	})
+}
+
+// LessThan [lt] query on number and date fields
+func (q *TypedQuery) LessThan(field string, value any) *TypedQuery {
+	return q.filter(field, QueryOperatorLessThan, value)
+}
+
+// LessThanOrEqual [lte] query on number and date fields
+func (q *TypedQuery) LessThanOrEqual(field string, value any) *TypedQuery {
+	return q.filter(field, QueryOperatorLessThanOrEqual, value)
+}
+
+// GreaterThan [gt] query on number and date fields
+func (q *TypedQuery) GreaterThan(field string, value any) *TypedQuery {
+	return q.filter(field, QueryOperatorGreaterThan, value)
+}
+
+// GreaterThanOrEqual [gte] query on number and date fields
+func (q *TypedQuery) GreaterThanOrEqual(field string, value any) *TypedQuery {
+	return q.filter(field, QueryOperatorGreaterThanOrEqual, value)
+}
+
+// Match [match] full text search on text fields
+func (q *TypedQuery) Match(field, text string) *TypedQuery {
+	return q.with(func(clone *TypedQuery) error {
+		f, err := clone.resolve(field, QueryOperatorMatch)
+		if err != nil {
+			return err
+		}
+
+		clone.query.Param(f.path+"[match]", text)
+
+		return nil
+	})
+}
+
+// Near [near] orders entries by the distance of a location field to the point
+func (q *TypedQuery) Near(field string, lat, lon float64) *TypedQuery {
+	return q.location(field, QueryOperatorNear, func(query *Query) { query.Near(field, lat, lon) })
+}
+
+// Within [within] matches entries whose location field lies in the bounding box
+func (q *TypedQuery) Within(field string, lat1, lon1, lat2, lon2 float64) *TypedQuery {
+	return q.location(field, QueryOperatorWithin, func(query *Query) { query.Within(field, lat1, lon1, lat2, lon2) })
+}
+
+// WithinRadius [within] matches entries whose location field lies in the circle, the radius is in km
+func (q *TypedQuery) WithinRadius(field string, lat, lon, radius float64) *TypedQuery {
+	return q.location(field, QueryOperatorWithin, func(query *Query) { query.WithinRadius(field, lat, lon, radius) })
+}
+
+// location applies a geo filter built by set on the resolved field path
+func (q *TypedQuery) location(field, operator string, set func(query *Query)) *TypedQuery {
+	return q.with(func(clone *TypedQuery) error {
+		f, err := clone.resolve(field, operator)
+		if err != nil {
+			return err
+		}
+
+		geo := NewQuery()
+		set(geo)
+		if err := geo.Validate(); err != nil {
+			return QueryBuildError{Field: f.path, Operator: operator, Reason: errors.Unwrap(err).Error()}
+		}
+
+		for key, values := range geo.values() {
+			clone.query.Param(strings.Replace(key, field, f.path, 1), values[0])
+		}
+
+		return nil
+	})
+}
+
+// Order sorts by the field, fields can be added to sort by several fields
+func (q *TypedQuery) Order(field string, reverse bool) *TypedQuery {
+	return q.with(func(clone *TypedQuery) error {
+		f, err := clone.resolve(field, "order")
+		if err != nil {
+			return err
+		}
+
+		clone.query.Order(f.path, reverse)
+
+		return nil
+	})
+}
+
+// Select limits the returned fields, sys is always returned
+func (q *TypedQuery) Select(fields ...string) *TypedQuery {
+	return q.with(func(clone *TypedQuery) error {
+		paths := []string{"sys"}
+		for _, field := range fields {
+			if field == "sys" || strings.HasPrefix(field, "sys.") {
+				continue
+			}
+
+			f, err := clone.resolvePath(field)
+			if err != nil {
+				return QueryBuildError{Field: field, Operator: "select", Reason: err.Error()}
+			}
+			paths = append(paths, f.path)
+		}
+
+		clone.query.Select(paths)
+
+		return nil
+	})
+}
+
+// Query [query] full text search on all text fields
+func (q *TypedQuery) Query(text string) *TypedQuery {
+	return q.with(func(clone *TypedQuery) error {
+		clone.query.Query(text)
+		return nil
+	})
+}
+
+// Include sets the levels of linked entries to include, at most 10
+func (q *TypedQuery) Include(include uint16) *TypedQuery {
+	return q.with(func(clone *TypedQuery) error {
+		if include > 10 {
+			return QueryBuildError{Operator: "include", Reason: "include value should be between 0 and 10"}
+		}
+
+		clone.query.Include(include)
+
+		return nil
+	})
+}
+
+// Limit sets the page size, at most 1000
+func (q *TypedQuery) Limit(limit uint16) *TypedQuery {
+	return q.with(func(clone *TypedQuery) error {
+		if limit > 1000 {
+			return QueryBuildError{Operator: "limit", Reason: "limit value should be between 0 and 1000"}
+		}
+
+		clone.query.Limit(limit)
+
+		return nil
+	})
+}
+
+// Skip sets the number of entries to skip
+func (q *TypedQuery) Skip(skip uint16) *TypedQuery {
+	return q.with(func(clone *TypedQuery) error {
+		clone.query.Skip(skip)
+		return nil
+	})
+}
+
+// Locale sets the locale of the returned entries
+func (q *TypedQuery) Locale(locale string) *TypedQuery {
+	return q.with(func(clone *TypedQuery) error {
+		clone.query.Locale(locale)
+		return nil
+	})
+}
+
+// LinksToEntry matches entries linking to the entry
+func (q *TypedQuery) LinksToEntry(entryID string) *TypedQuery {
+	return q.with(func(clone *TypedQuery) error {
+		clone.query.LinksToEntry(entryID)
+		return nil
+	})
+}
+
+// LinksToAsset matches entries linking to the asset
+func (q *TypedQuery) LinksToAsset(assetID string) *TypedQuery {
+	return q.with(func(clone *TypedQuery) error {
+		clone.query.LinksToAsset(assetID)
+		return nil
+	})
+}
+
+// TagsIn matches entries tagged with any of the tags
+func (q *TypedQuery) TagsIn(tagIDs ...string) *TypedQuery {
+	return q.with(func(clone *TypedQuery) error {
+		clone.query.TagsIn(tagIDs...)
+		return nil
+	})
+}
+
+// TagsAll matches entries tagged with all of the tags
+func (q *TypedQuery) TagsAll(tagIDs ...string) *TypedQuery {
+	return q.with(func(clone *TypedQuery) error {
+		clone.query.TagsAll(tagIDs...)
+		return nil
+	})
+}
+
+// Linked filters on the fields of the entries linked by field, the filters of
+// linked are checked against its own content type
+func (q *TypedQuery) Linked(field string, linked *TypedQuery) *TypedQuery {
+	return q.with(func(clone *TypedQuery) error {
+		if linked.err != nil {
+			return linked.err
+		}
+
+		f, err := clone.resolvePath(field)
+		if err != nil {
+			return QueryBuildError{Field: field, Operator: "linked", Reason: err.Error()}
+		}
+
+		linkField := clone.field(strings.TrimPrefix(f.path, "fields."))
+		if linkField == nil || linkType(linkField) != "Entry" {
+			return QueryBuildError{Field: f.path, Operator: "linked", Reason: "field does not link to entries"}
+		}
+
+		clone.query.Linked(f.path, linked.contentType.Sys.ID, linked.query)
+
+		return nil
+	})
+}
+
+// field returns the field of the content type or nil
+func (q *TypedQuery) field(id string) *Field {
+	for _, f := range q.contentType.Fields {
+		if f.ID == id {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// linkType returns the link type of Link and Array<Link> fields
+func linkType(field *Field) string {
+	if field.Type == FieldTypeArray && field.Items != nil {
+		return field.Items.LinkType
+	}
+
+	return field.LinkType
+}
+
+// formatQueryValue formats the value for a field of the given type
+func formatQueryValue(fieldType string, value any) (string, error) {
+	switch fieldType {
+	case FieldTypeSymbol, FieldTypeText, FieldTypeRichText, "":
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case fmt.Stringer:
+			return v.String(), nil
+		}
+		if fieldType == "" {
+			return formatQueryScalar(value)
+		}
+	case FieldTypeInteger:
+		switch v := value.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			return fmt.Sprintf("%d", v), nil
+		case float64:
+			if v == float64(int64(v)) {
+				return strconv.FormatInt(int64(v), 10), nil
+			}
+			return "", fmt.Errorf("%v is not an integer", v)
+		}
+	case FieldTypeNumber:
+		switch v := value.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			return fmt.Sprintf("%d", v), nil
+		case float32:
+			return strconv.FormatFloat(float64(v), 'f', -1, 32), nil
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		}
+	case FieldTypeBoolean:
+		if v, ok := value.(bool); ok {
+			return strconv.FormatBool(v), nil
+		}
+	case FieldTypeDate:
+		switch v := value.(type) {
+		case time.Time:
+			return v.UTC().Format(time.RFC3339), nil
+		case string:
+			if _, err := time.Parse(time.RFC3339, v); err != nil {
+				if _, err := time.Parse("2006-01-02", v); err != nil {
+					return "", fmt.Errorf("%q is not a date", v)
+				}
+			}
+			return v, nil
+		}
+	}
+
+	return "", fmt.Errorf("%T value can not be used for %s fields", value, fieldType)
+}
+
+// formatQueryScalar formats values of untyped paths, e.g. inside Object fields
+func formatQueryScalar(value any) (string, error) {
+	switch v := value.(type) {
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case time.Time:
+		return v.UTC().Format(time.RFC3339), nil
+	}
+
+	return "", fmt.Errorf("%T value can not be used in queries", value)
+}
@@ -0,0 +1,219 @@
+package contentful
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypedQuery(t *testing.T) {
+	assertions := assert.New(t)
+
+	contentType, err := contentTypeFromTestData("content_type_query.json")
+	assertions.Nil(err)
+
+	releaseDate := time.Date(2022, 4, 1, 10, 0, 0, 0, time.FixedZone("CET", 3600))
+
+	values, err := NewTypedQuery(contentType).
+		Equal("title", "Shoe").
+		NotEqual("fields.available", false).
+		In("stock", 1, 2, 3).
+		All("tags", "sale", "new").
+		GreaterThan("price", 9.99).
+		LessThanOrEqual("releaseDate", releaseDate).
+		Equal("brand.sys.id", "nike").
+		Exists("images", true).
+		Match("body", "running").
+		Equal("meta.color", "red").
+		Equal("sys.id", "product_id").
+		Near("store", 52.52, 13.405).
+		Order("price", true).
+		Limit(10).
+		Values()
+	assertions.Nil(err)
+
+	expected := url.Values{}
+	expected.Set("content_type", "product")
+	expected.Set("fields.title", "Shoe")
+	expected.Set("fields.available[ne]", "false")
+	expected.Set("fields.stock[in]", "1,2,3")
+	expected.Set("fields.tags[all]", "sale,new")
+	expected.Set("fields.price[gt]", "9.99")
+	expected.Set("fields.releaseDate[lte]", "2022-04-01T09:00:00Z")
+	expected.Set("fields.brand.sys.id", "nike")
+	expected.Set("fields.images[exists]", "true")
+	expected.Set("fields.body[match]", "running")
+	expected.Set("fields.meta.color", "red")
+	expected.Set("sys.id", "product_id")
+	expected.Set("fields.store[near]", "52.52,13.405")
+	expected.Set("order", "-fields.price")
+	expected.Set("limit", "10")
+	assertions.Equal(expected, values)
+}
+
+func TestTypedQuery_Errors(t *testing.T) {
+	assertions := assert.New(t)
+
+	contentType, err := contentTypeFromTestData("content_type_query.json")
+	assertions.Nil(err)
+
+	tests := []struct {
+		query  *TypedQuery
+		field  string
+		reason string
+	}{
+		{NewTypedQuery(contentType).Equal("missing", "x"), "missing", "field missing does not exist in content type product"},
+		{NewTypedQuery(contentType).Match("stock", "1"), "fields.stock", "operator is not supported for Integer fields"},
+		{NewTypedQuery(contentType).Near("title", 1, 2), "fields.title", "operator is not supported for Symbol fields"},
+		{NewTypedQuery(contentType).Match("images", "x"), "fields.images", "operator is not supported for Array fields"},
+		{NewTypedQuery(contentType).All("title", "x"), "fields.title", "operator is not supported for Symbol fields"},
+		{NewTypedQuery(contentType).GreaterThan("stock", 1.5), "fields.stock", "1.5 is not an integer"},
+		{NewTypedQuery(contentType).Equal("available", "yes"), "fields.available", "string value can not be used for Boolean fields"},
+		{NewTypedQuery(contentType).Equal("releaseDate", "tomorrow"), "fields.releaseDate", `"tomorrow" is not a date`},
+		{NewTypedQuery(contentType).Equal("title.en-US", "x"), "title.en-US", "Symbol fields have no attribute en-US"},
+		{NewTypedQuery(contentType).Equal("sys.unknown", "x"), "sys.unknown", "unknown sys attribute"},
+		{NewTypedQuery(contentType).Order("body", false), "fields.body", "operator is not supported for Text fields"},
+		{NewTypedQuery(contentType).Select("missing"), "missing", "field missing does not exist in content type product"},
+	}
+
+	for _, test := range tests {
+		_, err := test.query.Values()
+
+		var buildErr QueryBuildError
+		assertions.True(errors.As(err, &buildErr), test.field)
+		assertions.Equal(test.field, buildErr.Field)
+		assertions.Equal(test.reason, buildErr.Reason)
+	}
+
+	_, err = NewTypedQuery(contentType).Limit(2000).Build()
+	assertions.Equal("invalid query [limit]: limit value should be between 0 and 1000", err.Error())
+
+	_, err = NewTypedQuery(&ContentType{}).Build()
+	assertions.NotNil(err)
+}
+
+func TestTypedQuery_FirstErrorIsKept(t *testing.T) {
+	assertions := assert.New(t)
+
+	contentType, err := contentTypeFromTestData("content_type_query.json")
+	assertions.Nil(err)
+
+	q := NewTypedQuery(contentType).Equal("missing", "x").Equal("title", "Shoe").Match("stock", "x")
+
+	var buildErr QueryBuildError
+	assertions.True(errors.As(q.Err(), &buildErr))
+	assertions.Equal("missing", buildErr.Field)
+}
+
+func TestTypedQuery_Immutable(t *testing.T) {
+	assertions := assert.New(t)
+
+	contentType, err := contentTypeFromTestData("content_type_query.json")
+	assertions.Nil(err)
+
+	base := NewTypedQuery(contentType).Equal("available", true)
+	shoes := base.Equal("title", "Shoe")
+	cheap := base.LessThan("price", 20)
+	invalid := base.Match("stock", "x")
+
+	values, err := base.Values()
+	assertions.Nil(err)
+	assertions.Equal("", values.Get("fields.title"))
+	assertions.Equal("", values.Get("fields.price[lt]"))
+
+	values, err = shoes.Values()
+	assertions.Nil(err)
+	assertions.Equal("Shoe", values.Get("fields.title"))
+	assertions.Equal("", values.Get("fields.price[lt]"))
+
+	values, err = cheap.Values()
+	assertions.Nil(err)
+	assertions.Equal("20", values.Get("fields.price[lt]"))
+
+	assertions.NotNil(invalid.Err())
+	assertions.Nil(base.Err())
+
+	query, err := base.Build()
+	assertions.Nil(err)
+	query.Skip(100)
+
+	values, err = base.Values()
+	assertions.Nil(err)
+	assertions.Equal("", values.Get("skip"))
+}
+
+func TestTypedQuery_Select(t *testing.T) {
+	assertions := assert.New(t)
+
+	contentType, err := contentTypeFromTestData("content_type_query.json")
+	assertions.Nil(err)
+
+	values, err := NewTypedQuery(contentType).Select("title", "fields.price", "sys.id").Values()
+	assertions.Nil(err)
+	assertions.Equal("sys,fields.title,fields.price", values.Get("select"))
+}
+
+func TestTypedQuery_Linked(t *testing.T) {
+	assertions := assert.New(t)
+
+	product, err := contentTypeFromTestData("content_type_query.json")
+	assertions.Nil(err)
+
+	person, err := contentTypeFromTestData("content_type_person.json")
+	assertions.Nil(err)
+
+	values, err := NewTypedQuery(product).
+		Linked("brand", NewTypedQuery(person).Match("name", "Jane").GreaterThan("age", 30)).
+		TagsIn("sale").
+		TagsAll("shoes", "new").
+		LinksToAsset("asset_id").
+		Values()
+	assertions.Nil(err)
+
+	expected := url.Values{}
+	expected.Set("content_type", "product")
+	expected.Set("fields.brand.sys.contentType.sys.id", "person")
+	expected.Set("fields.brand.fields.name[match]", "Jane")
+	expected.Set("fields.brand.fields.age[gt]", "30")
+	expected.Set("metadata.tags.sys.id[in]", "sale")
+	expected.Set("metadata.tags.sys.id[all]", "shoes,new")
+	expected.Set("links_to_asset", "asset_id")
+	assertions.Equal(expected, values)
+
+	var buildErr QueryBuildError
+
+	_, err = NewTypedQuery(product).Linked("images", NewTypedQuery(person)).Build()
+	assertions.True(errors.As(err, &buildErr))
+	assertions.Equal("field does not link to entries", buildErr.Reason)
+
+	_, err = NewTypedQuery(product).Linked("brand", NewTypedQuery(person).Match("age", "x")).Build()
+	assertions.True(errors.As(err, &buildErr))
+	assertions.Equal("fields.age", buildErr.Field)
+
+	_, err = NewTypedQuery(product).LinksToEntry("entry_id").Build()
+	assertions.Nil(err)
+}
+
+func TestTypedQuery_Geo(t *testing.T) {
+	assertions := assert.New(t)
+
+	contentType, err := contentTypeFromTestData("content_type_query.json")
+	assertions.Nil(err)
+
+	values, err := NewTypedQuery(contentType).WithinRadius("store", 52.52, 13.405, 10).Values()
+	assertions.Nil(err)
+	assertions.Equal("52.52,13.405,10", values.Get("fields.store[within]"))
+
+	values, err = NewTypedQuery(contentType).Within("fields.store", 52.3, 13.1, 52.7, 13.7).Values()
+	assertions.Nil(err)
+	assertions.Equal("52.3,13.1,52.7,13.7", values.Get("fields.store[within]"))
+
+	var buildErr QueryBuildError
+	_, err = NewTypedQuery(contentType).Near("store", 100, 0).Build()
+	assertions.True(errors.As(err, &buildErr))
+	assertions.Equal("fields.store", buildErr.Field)
+	assertions.Equal("latitude 100 should be between -90 and 90", buildErr.Reason)
+}
@@ -1,17 +1,30 @@
 package contentful
 
+import "encoding/json"
+
 // Sys model
 type Sys struct {
 	ID               string       `json:"id,omitempty"`
 	Type             string       `json:"type,omitempty"`
 	LinkType         string       `json:"linkType,omitempty"`
 	CreatedAt        string       `json:"createdAt,omitempty"`
+	CreatedBy        *Sys         `json:"createdBy,omitempty"`
 	UpdatedAt        string       `json:"updatedAt,omitempty"`
 	UpdatedBy        *Sys         `json:"updatedBy,omitempty"`
 	Version          int          `json:"version,omitempty"`
 	Revision         int          `json:"revision,omitempty"`
 	ContentType      *ContentType `json:"contentType,omitempty"`
 	Space            *Space       `json:"space,omitempty"`
+	Environment      *Link        `json:"environment,omitempty"`
+	Locale           string       `json:"locale,omitempty"`
+	User             *Link        `json:"user,omitempty"`
+	Team             *Link        `json:"team,omitempty"`
+	Release          *Link        `json:"release,omitempty"`
+	AppDefinition    *Link        `json:"appDefinition,omitempty"`
+	ParentEntity     *Link        `json:"parentEntity,omitempty"`
+	Parent           *Link        `json:"parent,omitempty"`
+	ResolvedAt       string       `json:"resolvedAt,omitempty"`
+	ResolvedBy       *Link        `json:"resolvedBy,omitempty"`
 	FirstPublishedAt string       `json:"firstPublishedAt,omitempty"`
 	PublishedCounter int          `json:"publishedCounter,omitempty"`
 	PublishedAt      string       `json:"publishedAt,omitempty"`
@@ -20,4 +33,54 @@ type Sys struct {
 	ArchivedAt       string       `json:"archivedAt,omitempty"`
 	ArchivedBy       *Sys         `json:"archivedBy,omitempty"`
 	ArchivedVersion  int          `json:"archivedVersion,omitempty"`
+	Status           SysStatus    `json:"status,omitempty"`
+	ExpiresAt        string       `json:"expiresAt,omitempty"`
+	LastUsedAt       string       `json:"lastUsedAt,omitempty"`
+}
+
+// Metadata model of entries and assets
+type Metadata struct {
+	Tags []Link `json:"tags"`
+}
+
+// SysStatus model, environments send the status as a link while
+// other entities like scheduled actions send a plain string
+type SysStatus string
+
+// UnmarshalJSON for custom json unmarshaling
+func (status *SysStatus) UnmarshalJSON(data []byte) error {
+	var id string
+	if err := json.Unmarshal(data, &id); err == nil {
+		*status = SysStatus(id)
+		return nil
+	}
+
+	var link struct {
+		Sys *Sys `json:"sys"`
+	}
+	if err := json.Unmarshal(data, &link); err != nil {
+		return err
+	}
+
+	if link.Sys != nil {
+		*status = SysStatus(link.Sys.ID)
+	}
+
+	return nil
+}
+
+// Link model, a reference to another entity
+type Link struct {
+	Sys *Sys `json:"sys"`
+}
+
+// NewLink returns a link to the entity of the given type and id
+func NewLink(linkType, id string) *Link {
+	return &Link{
+		Sys: &Sys{
+			Type:     "Link",
+			LinkType: linkType,
+			ID:       id,
+		},
+	}
 }
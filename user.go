@@ -3,6 +3,7 @@ package contentful
 import (
 	"context"
 	"fmt"
+	"net/http"
 )
 
 // UsersService service
@@ -38,3 +39,37 @@ func (service *UsersService) Me(ctx context.Context) (*User, error) {
 
 	return &user, nil
 }
+
+// ListSpaceUsers returns the users with access to the space
+func (service *UsersService) ListSpaceUsers(ctx context.Context, spaceID string, query *Query) (*Collection[User], error) {
+	path := fmt.Sprintf("/spaces/%s/users", spaceID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := newCollection[User](query, service.c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return col, nil
+}
+
+// ListOrganizationUsers returns the users of the organization
+func (service *UsersService) ListOrganizationUsers(ctx context.Context, organizationID string, query *Query) (*Collection[User], error) {
+	path := fmt.Sprintf("/organizations/%s/users", organizationID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := newCollection[User](query, service.c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return col, nil
+}
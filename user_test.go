@@ -62,3 +62,56 @@ func TestUsersService_Me_2(t *testing.T) {
 	_, err = cma.Users.Me(context.Background())
 	assertions.NotEmpty(err)
 }
+
+func TestUsersService_ListSpaceUsers(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/users")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("user.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	collection, err := cma.Users.ListSpaceUsers(context.Background(), spaceID, nil)
+	assertions.Nil(err)
+	assertions.Equal(2, len(collection.Items))
+	assertions.Equal("j.doe@labdigital.nl", collection.Items[0].Email)
+}
+
+func TestUsersService_ListOrganizationUsers(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/organizations/"+organizationID+"/users")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("user.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	collection, err := cma.Users.ListOrganizationUsers(context.Background(), organizationID, nil)
+	assertions.Nil(err)
+	assertions.Equal("7BslKh9TdKGOK41VmLDjFZ", collection.Items[1].Sys.ID)
+}
@@ -80,7 +80,7 @@ func (service *WebhookCallsService) Get(ctx context.Context, spaceID, webhookID,
 	}
 
 	var webHook WebhookCall
-	if ok := service.c.do(req, &webHook); ok != nil {
+	if err := service.c.do(req, &webHook); err != nil {
 		return nil, err
 	}
 
@@ -99,7 +99,7 @@ func (service *WebhookCallsService) Health(ctx context.Context, spaceID, webhook
 	}
 
 	var health WebhookHealth
-	if ok := service.c.do(req, &health); ok != nil {
+	if err := service.c.do(req, &health); err != nil {
 		return nil, err
 	}
 
@@ -91,7 +91,7 @@ func TestWebhookCallsService_Get_2(t *testing.T) {
 	cma.BaseURL = server.URL
 
 	_, err = cma.WebhookCalls.Get(context.Background(), spaceID, "0KzM2HxYr5O1pZ4SaUzK8h", "bar")
-	assertions.Empty(err)
+	assertions.NotNil(err)
 }
 
 func TestWebhookCallsService_Health(t *testing.T) {
@@ -145,5 +145,5 @@ func TestWebhookCallsService_Health_2(t *testing.T) {
 	cma.BaseURL = server.URL
 
 	_, err = cma.WebhookCalls.Health(context.Background(), spaceID, "0KzM2HxYr5O1pZ4SaUzK8h")
-	assertions.Nil(err)
+	assertions.NotNil(err)
 }